@@ -0,0 +1,64 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// metaCharset matches the character set declared by an HTML meta element,
+// either with its charset attribute or with the charset parameter of an
+// http-equiv Content-Type.
+var metaCharset = regexp.MustCompile(`(?i)<meta[^>]*?charset\s*=\s*["']?\s*([a-z0-9_:.+-]+)`)
+
+// documentCharset returns the character set of an HTML document declared
+// by its byte order mark or, within the first 1024 bytes, a meta element.
+// ok is false if the document declares no character set.
+func documentCharset(data []byte) (charset string, ok bool) {
+	switch {
+	case bytes.HasPrefix(data, []byte("\xef\xbb\xbf")):
+		return "UTF-8", true
+	case bytes.HasPrefix(data, []byte("\xfe\xff")):
+		return "UTF-16BE", true
+	case bytes.HasPrefix(data, []byte("\xff\xfe")):
+		return "UTF-16LE", true
+	}
+	head := data
+	if len(head) > 1024 {
+		head = head[:1024]
+	}
+	if m := metaCharset.FindSubmatch(head); m != nil {
+		return string(m[1]), true
+	}
+	return "", false
+}
+
+// sourceCharset returns the character set to decode the source data of a
+// page from: custom, the WebView's custom charset, if set, or else the
+// character set declared by the document.  A document without a declared
+// character set is assumed to be UTF-8 if it is valid UTF-8, as is common
+// for pages whose character set is only given by the Content-Type header.
+// Otherwise, an error is returned.
+func sourceCharset(custom string, data []byte) (string, error) {
+	if custom != "" {
+		return custom, nil
+	}
+	if charset, ok := documentCharset(data); ok {
+		return charset, nil
+	}
+	if utf8.Valid(data) {
+		return "UTF-8", nil
+	}
+	return "", errors.New("wk2: page character set can not be determined")
+}
+
+// isUTF8Charset returns whether charset names UTF-8.
+func isUTF8Charset(charset string) bool {
+	return strings.EqualFold(charset, "UTF-8") || strings.EqualFold(charset, "UTF8")
+}
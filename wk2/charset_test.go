@@ -0,0 +1,83 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import "testing"
+
+func TestDocumentCharset(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		charset string
+		ok      bool
+	}{
+		{"utf-8 bom", "\xef\xbb\xbf<p>x</p>", "UTF-8", true},
+		{"utf-16be bom", "\xfe\xff\x00<", "UTF-16BE", true},
+		{"utf-16le bom", "\xff\xfe<\x00", "UTF-16LE", true},
+		{"meta charset", `<meta charset="Shift_JIS">`, "Shift_JIS", true},
+		{"meta charset unquoted", `<META CHARSET=euc-jp>`, "euc-jp", true},
+		{"meta http-equiv", `<meta http-equiv="Content-Type" content="text/html; charset=iso-8859-1">`, "iso-8859-1", true},
+		{"no declaration", "<p>x</p>", "", false},
+		{"empty", "", "", false},
+	}
+	for _, test := range tests {
+		charset, ok := documentCharset([]byte(test.data))
+		if charset != test.charset || ok != test.ok {
+			t.Errorf("%s: got (%q, %v), want (%q, %v)", test.name,
+				charset, ok, test.charset, test.ok)
+		}
+	}
+}
+
+func TestDocumentCharsetHead(t *testing.T) {
+	data := make([]byte, 1024, 1100)
+	for i := range data {
+		data[i] = ' '
+	}
+	data = append(data, `<meta charset="koi8-r">`...)
+	if charset, ok := documentCharset(data); ok {
+		t.Errorf("declaration after 1024 bytes was found: %q", charset)
+	}
+}
+
+func TestSourceCharset(t *testing.T) {
+	tests := []struct {
+		name    string
+		custom  string
+		data    string
+		charset string
+		err     bool
+	}{
+		{"custom", "windows-1252", `<meta charset="utf-8">`, "windows-1252", false},
+		{"declared", "", `<meta charset="iso-8859-2">`, "iso-8859-2", false},
+		{"ascii", "", "<p>x</p>", "UTF-8", false},
+		{"undeclared utf-8", "", "<p>été</p>", "UTF-8", false},
+		{"undeclared invalid utf-8", "", "<p>\xe9t\xe9</p>", "", true},
+	}
+	for _, test := range tests {
+		charset, err := sourceCharset(test.custom, []byte(test.data))
+		if (err != nil) != test.err {
+			t.Errorf("%s: unexpected error state: %v", test.name, err)
+			continue
+		}
+		if charset != test.charset {
+			t.Errorf("%s: got %q, want %q", test.name, charset,
+				test.charset)
+		}
+	}
+}
+
+func TestIsUTF8Charset(t *testing.T) {
+	for _, charset := range []string{"UTF-8", "utf-8", "utf8"} {
+		if !isUTF8Charset(charset) {
+			t.Errorf("%q is not UTF-8", charset)
+		}
+	}
+	for _, charset := range []string{"", "UTF-16", "latin1"} {
+		if isUTF8Charset(charset) {
+			t.Errorf("%q is UTF-8", charset)
+		}
+	}
+}
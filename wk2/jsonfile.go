@@ -0,0 +1,48 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// readJSONFile decodes the JSON file at path into v.  A missing file is
+// not an error, and leaves v unmodified.
+func readJSONFile(path string, v interface{}) error {
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// writeJSONFile writes the JSON encoding of v to the file at path.  The
+// file is replaced atomically, so a failed write does not lose the
+// previous contents.
+func writeJSONFile(path string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
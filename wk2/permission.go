@@ -0,0 +1,113 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import (
+	"net/url"
+	"sync"
+)
+
+// PermissionStore is a PermissionPolicy which answers permission requests
+// from decisions remembered for each origin, and persists the decisions
+// to a JSON file.
+//
+// Requests without a remembered decision are passed to Fallback.  If
+// Fallback is nil, they are denied, so no permission prompt is ever shown
+// for an origin without a remembered decision.
+type PermissionStore struct {
+	Fallback PermissionPolicy
+
+	path string
+
+	mu        sync.Mutex
+	decisions map[string]map[PermissionKind]bool
+}
+
+// OpenPermissionStore returns a PermissionStore persisting decisions to
+// the JSON file at path.  Decisions are loaded from the file if it
+// exists.
+func OpenPermissionStore(path string) (*PermissionStore, error) {
+	s := &PermissionStore{
+		path:      path,
+		decisions: make(map[string]map[PermissionKind]bool),
+	}
+	if err := readJSONFile(path, &s.decisions); err != nil {
+		return nil, err
+	}
+	if s.decisions == nil {
+		s.decisions = make(map[string]map[PermissionKind]bool)
+	}
+	return s, nil
+}
+
+// Decision returns the decision remembered for permissions of kind
+// requested by origin.  ok is false if no decision is remembered.
+func (s *PermissionStore) Decision(origin string, kind PermissionKind) (allow, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	allow, ok = s.decisions[origin][kind]
+	return allow, ok
+}
+
+// SetDecision remembers whether permissions of kind requested by origin
+// are allowed, and saves the PermissionStore.
+func (s *PermissionStore) SetDecision(origin string, kind PermissionKind, allow bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.decisions[origin]
+	if !ok {
+		m = make(map[PermissionKind]bool)
+		s.decisions[origin] = m
+	}
+	m[kind] = allow
+	return writeJSONFile(s.path, s.decisions)
+}
+
+// Forget removes the decision remembered for permissions of kind
+// requested by origin, and saves the PermissionStore.
+func (s *PermissionStore) Forget(origin string, kind PermissionKind) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.decisions[origin][kind]; !ok {
+		return nil
+	}
+	delete(s.decisions[origin], kind)
+	if len(s.decisions[origin]) == 0 {
+		delete(s.decisions, origin)
+	}
+	return writeJSONFile(s.path, s.decisions)
+}
+
+// DecidePermission satisfies the PermissionPolicy interface.
+func (s *PermissionStore) DecidePermission(origin string, r PermissionRequest) bool {
+	if origin != "" {
+		if allow, ok := s.Decision(origin, r.Kind()); ok {
+			if allow {
+				r.Allow()
+			} else {
+				r.Deny()
+			}
+			return true
+		}
+	}
+	if s.Fallback != nil {
+		return s.Fallback.DecidePermission(origin, r)
+	}
+	r.Deny()
+	return true
+}
+
+// uriOrigin returns the origin (scheme, host and port) of uri, or the
+// empty string if uri can not be parsed or has no host.
+func uriOrigin(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// testPermissionRequest is a PermissionRequest recording its answer.
+type testPermissionRequest struct {
+	kind    PermissionKind
+	answers []bool
+}
+
+func (r *testPermissionRequest) Allow()               { r.answers = append(r.answers, true) }
+func (r *testPermissionRequest) Deny()                { r.answers = append(r.answers, false) }
+func (r *testPermissionRequest) Kind() PermissionKind { return r.kind }
+
+// testPermissionPolicy is a PermissionPolicy recording the origins it is
+// asked about, and leaving requests undecided.
+type testPermissionPolicy struct {
+	origins []string
+}
+
+func (p *testPermissionPolicy) DecidePermission(origin string, r PermissionRequest) bool {
+	p.origins = append(p.origins, origin)
+	return false
+}
+
+func TestPermissionStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "permissions.json")
+	s, err := OpenPermissionStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetDecision("https://allowed.example", PermissionGeolocation, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetDecision("https://denied.example", PermissionGeolocation, false); err != nil {
+		t.Fatal(err)
+	}
+	s, err = OpenPermissionStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		origin   string
+		fallback bool
+		handled  bool
+		answers  []bool
+		asked    []string
+	}{
+		{"remembered allow", "https://allowed.example", false, true, []bool{true}, nil},
+		{"remembered deny", "https://denied.example", false, true, []bool{false}, nil},
+		{"unknown denied by default", "https://unknown.example", false, true, []bool{false}, nil},
+		{"unknown origin to fallback", "https://unknown.example", true, false, nil, []string{"https://unknown.example"}},
+		{"no origin to fallback", "", true, false, nil, []string{""}},
+		{"remembered not sent to fallback", "https://allowed.example", true, true, []bool{true}, nil},
+	}
+	for _, test := range tests {
+		var fallback *testPermissionPolicy
+		s.Fallback = nil
+		if test.fallback {
+			fallback = new(testPermissionPolicy)
+			s.Fallback = fallback
+		}
+		r := &testPermissionRequest{kind: PermissionGeolocation}
+		handled := s.DecidePermission(test.origin, r)
+		if handled != test.handled {
+			t.Errorf("%s: handled = %v, want %v", test.name, handled,
+				test.handled)
+		}
+		if !equalBools(r.answers, test.answers) {
+			t.Errorf("%s: answers = %v, want %v", test.name,
+				r.answers, test.answers)
+		}
+		if fallback != nil && !equalStrings(fallback.origins, test.asked) {
+			t.Errorf("%s: fallback asked about %q, want %q",
+				test.name, fallback.origins, test.asked)
+		}
+	}
+}
+
+func TestPermissionStoreForget(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "permissions.json")
+	s, err := OpenPermissionStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const origin = "https://example.com"
+	if err := s.SetDecision(origin, PermissionGeolocation, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Forget(origin, PermissionGeolocation); err != nil {
+		t.Fatal(err)
+	}
+	s, err = OpenPermissionStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.Decision(origin, PermissionGeolocation); ok {
+		t.Error("forgotten decision was saved")
+	}
+}
+
+func TestURIOrigin(t *testing.T) {
+	tests := []struct {
+		uri    string
+		origin string
+	}{
+		{"https://example.com/a/b?c#d", "https://example.com"},
+		{"http://example.com:8080/", "http://example.com:8080"},
+		{"https://user@example.com/", "https://example.com"},
+		{"about:blank", ""},
+		{"file:///etc/hosts", ""},
+		{"", ""},
+		{"%", ""},
+	}
+	for _, test := range tests {
+		if origin := uriOrigin(test.uri); origin != test.origin {
+			t.Errorf("uriOrigin(%q) = %q, want %q", test.uri, origin,
+				test.origin)
+		}
+	}
+}
+
+func equalBools(a, b []bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import (
+	"net/url"
+	"sync"
+)
+
+// SecurityState describes how securely the page of a WebView was loaded,
+// such as for choosing the lock icon of an address bar.
+type SecurityState int
+
+// These constants define the security states of a WebView.
+const (
+	// SecurityInsecure is the state of a WebView whose page was not
+	// loaded over HTTPS, or which has not committed a load.
+	SecurityInsecure SecurityState = iota
+
+	// SecuritySecure is the state of a WebView whose page was loaded
+	// over HTTPS with a certificate reported valid by
+	// WebView.TLSInfo, and which has not used any insecure content.
+	SecuritySecure
+
+	// SecurityMixed is the state of a WebView whose page was loaded
+	// over HTTPS with a valid certificate, but which has run or
+	// displayed insecure content.
+	SecurityMixed
+
+	// SecurityBroken is the state of a WebView whose page was loaded,
+	// or failed to load, over HTTPS with a certificate which failed
+	// validation.  This includes certificates accepted because of
+	// TLSErrorsPolicyIgnore or WebContext.AllowTLSCertificateForHost.
+	SecurityBroken
+)
+
+var securityStateStrings = map[SecurityState]string{
+	SecurityInsecure: "insecure",
+	SecuritySecure:   "secure",
+	SecurityMixed:    "mixed",
+	SecurityBroken:   "broken",
+}
+
+// String returns a description of the SecurityState.
+func (s SecurityState) String() string {
+	if str, ok := securityStateStrings[s]; ok {
+		return str
+	}
+	return "unknown"
+}
+
+// securityTracker follows the security state of a single WebView.
+type securityTracker struct {
+	state   SecurityState
+	changed []func(SecurityState)
+}
+
+// securityTrackers holds the securityTracker of each WebView whose
+// security state is followed, keyed by the address of the WebKitWebView.
+var securityTrackers = struct {
+	sync.Mutex
+	m map[uintptr]*securityTracker
+}{
+	m: make(map[uintptr]*securityTracker),
+}
+
+// SecurityState returns the security state of the WebView.  The state is
+// followed from the first call to SecurityState or
+// OnSecurityStateChanged, so one of these should be called before the
+// WebView begins loading.
+func (w *WebView) SecurityState() SecurityState {
+	t, err := w.securityTracker()
+	if err != nil {
+		return SecurityInsecure
+	}
+	securityTrackers.Lock()
+	defer securityTrackers.Unlock()
+	return t.state
+}
+
+// OnSecurityStateChanged registers f to be called with the new security
+// state of the WebView each time it changes.  See SecurityState for when
+// it must be called.
+func (w *WebView) OnSecurityStateChanged(f func(SecurityState)) error {
+	t, err := w.securityTracker()
+	if err != nil {
+		return err
+	}
+	securityTrackers.Lock()
+	t.changed = append(t.changed, f)
+	securityTrackers.Unlock()
+	return nil
+}
+
+// securityTracker returns the securityTracker of the WebView, creating it
+// and connecting it to the WebView's signals if it does not yet exist.
+func (w *WebView) securityTracker() (*securityTracker, error) {
+	key := w.Native()
+	securityTrackers.Lock()
+	t, ok := securityTrackers.m[key]
+	if ok {
+		securityTrackers.Unlock()
+		return t, nil
+	}
+	t = &securityTracker{state: uriSecurityState(w.URI())}
+	securityTrackers.m[key] = t
+	securityTrackers.Unlock()
+
+	err := w.connectSecurityTracker(t)
+	if err != nil {
+		securityTrackers.Lock()
+		delete(securityTrackers.m, key)
+		securityTrackers.Unlock()
+		return nil, err
+	}
+	return t, nil
+}
+
+// connectSecurityTracker connects the signal handlers updating t.
+func (w *WebView) connectSecurityTracker(t *securityTracker) error {
+	_, err := w.OnLoadChanged(func(e LoadEvent) {
+		if e != LoadCommitted {
+			return
+		}
+		state := uriSecurityState(w.URI())
+		if state == SecuritySecure {
+			_, flags, ok := w.TLSInfo()
+			if !ok {
+				state = SecurityInsecure
+			} else if flags != 0 {
+				state = SecurityBroken
+			}
+		}
+		t.set(state)
+	})
+	if err != nil {
+		return err
+	}
+	// Failed loads are followed with an emission hook rather than a
+	// handler, which could be skipped by an earlier handler returning
+	// true.
+	addSecurityTLSErrorsHook()
+	_, err = w.OnInsecureContentDetected(func(InsecureContentEvent) {
+		securityTrackers.Lock()
+		secure := t.state == SecuritySecure
+		securityTrackers.Unlock()
+		if secure {
+			t.set(SecurityMixed)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	key := w.Native()
+	_, err = w.Connect("destroy", func() {
+		securityTrackers.Lock()
+		delete(securityTrackers.m, key)
+		securityTrackers.Unlock()
+	})
+	return err
+}
+
+// securityTLSErrors marks the WebView with the address key as broken, if
+// its security state is followed.  It is called by the emission hook of
+// the load-failed-with-tls-errors signal.
+func securityTLSErrors(key uintptr) {
+	securityTrackers.Lock()
+	t := securityTrackers.m[key]
+	securityTrackers.Unlock()
+	if t != nil {
+		t.set(SecurityBroken)
+	}
+}
+
+// set changes the state of t, calling the change callbacks if it differs
+// from the previous state.
+func (t *securityTracker) set(state SecurityState) {
+	securityTrackers.Lock()
+	if t.state == state {
+		securityTrackers.Unlock()
+		return
+	}
+	t.state = state
+	changed := t.changed
+	securityTrackers.Unlock()
+
+	for _, f := range changed {
+		f(state)
+	}
+}
+
+// uriSecurityState returns the security state of a page committed from
+// uri, not considering any certificate errors or insecure content.
+func uriSecurityState(uri string) SecurityState {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "https" {
+		return SecurityInsecure
+	}
+	return SecuritySecure
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import "testing"
+
+func TestURISecurityState(t *testing.T) {
+	tests := []struct {
+		uri   string
+		state SecurityState
+	}{
+		{"https://example.com/", SecuritySecure},
+		{"https://example.com:8443/a", SecuritySecure},
+		{"http://example.com/", SecurityInsecure},
+		{"file:///tmp/a.html", SecurityInsecure},
+		{"about:blank", SecurityInsecure},
+		{"", SecurityInsecure},
+		{"%", SecurityInsecure},
+	}
+	for _, test := range tests {
+		if state := uriSecurityState(test.uri); state != test.state {
+			t.Errorf("uriSecurityState(%q) = %v, want %v", test.uri,
+				state, test.state)
+		}
+	}
+}
+
+func TestSecurityStateString(t *testing.T) {
+	tests := []struct {
+		state SecurityState
+		want  string
+	}{
+		{SecurityInsecure, "insecure"},
+		{SecuritySecure, "secure"},
+		{SecurityMixed, "mixed"},
+		{SecurityBroken, "broken"},
+		{SecurityState(-1), "unknown"},
+	}
+	for _, test := range tests {
+		if got := test.state.String(); got != test.want {
+			t.Errorf("SecurityState(%d).String() = %q, want %q",
+				int(test.state), got, test.want)
+		}
+	}
+}
+
+func TestSecurityTrackerSet(t *testing.T) {
+	var got []SecurityState
+	tr := &securityTracker{}
+	tr.changed = append(tr.changed, func(s SecurityState) {
+		got = append(got, s)
+	})
+	for _, s := range []SecurityState{SecuritySecure, SecuritySecure,
+		SecurityMixed, SecurityBroken, SecurityBroken} {
+		tr.set(s)
+	}
+	want := []SecurityState{SecuritySecure, SecurityMixed, SecurityBroken}
+	if len(got) != len(want) {
+		t.Fatalf("callbacks got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("callbacks got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSecurityTLSErrors(t *testing.T) {
+	const key = 0x1000
+	tr := &securityTracker{state: SecuritySecure}
+	securityTrackers.Lock()
+	securityTrackers.m[key] = tr
+	securityTrackers.Unlock()
+	defer func() {
+		securityTrackers.Lock()
+		delete(securityTrackers.m, key)
+		securityTrackers.Unlock()
+	}()
+
+	securityTLSErrors(key)
+	if tr.state != SecurityBroken {
+		t.Errorf("state after TLS errors is %v, want broken", tr.state)
+	}
+	// WebViews whose state is not followed are ignored.
+	securityTLSErrors(key + 1)
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"sync"
+	"time"
+
+	"github.com/conformal/gotk3/glib"
+)
+
+// Default values used by a CrashSupervisor when its fields are zero.
+const (
+	DefaultMaxCrashes   = 5
+	DefaultInitialDelay = time.Second
+	DefaultMaxDelay     = time.Minute
+)
+
+// CrashPage is the data passed to a CrashSupervisor's ErrorPage template.
+type CrashPage struct {
+	URI     string
+	Crashes int
+}
+
+var defaultCrashPage = template.Must(template.New("crash").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Page crashed</title></head>
+<body>
+<h1>This page could not be displayed</h1>
+{{if .URI}}<p>The page at {{.URI}} crashed {{.Crashes}} times and will not be
+reloaded.</p>{{else}}<p>The page crashed before it finished loading.</p>{{end}}
+</body>
+</html>
+`))
+
+// CrashSupervisor recovers WebViews from crashes of their web process.
+// After a crash, an attached WebView reloads the last URI it committed,
+// waiting twice as long after each consecutive crash.  Once the WebView
+// crashes MaxCrashes times, it is no longer reloaded, and the ErrorPage
+// template is shown instead.  The ErrorPage is also shown immediately if
+// the WebView crashes before committing any load, with an empty URI.
+//
+// Fields must not be changed after a WebView is attached.
+type CrashSupervisor struct {
+	// MaxCrashes is the number of crashes after which a WebView is no
+	// longer reloaded.  If zero, DefaultMaxCrashes is used.
+	MaxCrashes int
+
+	// InitialDelay is the time waited before reloading a WebView after
+	// its first crash.  If zero, DefaultInitialDelay is used.
+	InitialDelay time.Duration
+
+	// MaxDelay limits the time waited before reloading a WebView.  If
+	// zero, DefaultMaxDelay is used.
+	MaxDelay time.Duration
+
+	// ResetAfter, if non-zero, is the time after which a WebView that
+	// has not crashed again has its crash count reset.
+	ResetAfter time.Duration
+
+	// ErrorPage is executed with a CrashPage to create the page shown
+	// once a WebView reaches MaxCrashes.  If nil, or if executing it
+	// fails, a plain default page is used.
+	ErrorPage *template.Template
+
+	// OnError, if non-nil, is called with each error executing an error
+	// page template.
+	OnError func(error)
+
+	mu    sync.Mutex
+	views map[uintptr]*crashRecord
+}
+
+// crashRecord holds the crash history of a single WebView.
+type crashRecord struct {
+	uri       string
+	crashes   int
+	lastCrash time.Time
+}
+
+// Attach connects w to the CrashSupervisor, so the last URI w commits is
+// remembered and w is recovered from each crash of its web process.  An
+// error is returned if w is already attached.
+func (s *CrashSupervisor) Attach(w *WebView) error {
+	key := w.Native()
+	s.mu.Lock()
+	if s.views == nil {
+		s.views = make(map[uintptr]*crashRecord)
+	}
+	if _, ok := s.views[key]; ok {
+		s.mu.Unlock()
+		return errors.New("wk2: web view is already attached to the crash supervisor")
+	}
+	s.views[key] = &crashRecord{}
+	s.mu.Unlock()
+
+	_, err := w.OnLoadChanged(func(e LoadEvent) {
+		if e != LoadCommitted {
+			return
+		}
+		s.mu.Lock()
+		if r, ok := s.views[key]; ok {
+			r.uri = w.URI()
+		}
+		s.mu.Unlock()
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.OnWebProcessCrashed(func() bool {
+		s.crashed(w)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Connect("destroy", func() {
+		s.mu.Lock()
+		delete(s.views, key)
+		s.mu.Unlock()
+	})
+	return err
+}
+
+// Crashes returns the number of crashes recorded for w.
+func (s *CrashSupervisor) Crashes(w *WebView) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, ok := s.views[w.Native()]; ok {
+		return r.crashes
+	}
+	return 0
+}
+
+// Reset clears the crashes recorded for w, so it is reloaded after its
+// next crash even if it had reached MaxCrashes.
+func (s *CrashSupervisor) Reset(w *WebView) {
+	s.mu.Lock()
+	if r, ok := s.views[w.Native()]; ok {
+		r.crashes = 0
+	}
+	s.mu.Unlock()
+}
+
+// crashed records a crash of w, and either schedules w to be reloaded or
+// shows the error page.
+func (s *CrashSupervisor) crashed(w *WebView) {
+	key := w.Native()
+	now := time.Now()
+	s.mu.Lock()
+	r, ok := s.views[key]
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	if s.ResetAfter != 0 && now.Sub(r.lastCrash) > s.ResetAfter {
+		r.crashes = 0
+	}
+	r.crashes++
+	r.lastCrash = now
+	page := CrashPage{URI: r.uri, Crashes: r.crashes}
+	s.mu.Unlock()
+
+	// A WebView which crashed before committing any load has nothing
+	// to reload, so the error page is shown immediately.
+	if page.URI == "" {
+		w.LoadAlternateHTML(s.errorPage(page), "about:blank", "")
+		return
+	}
+	if page.Crashes >= s.maxCrashes() {
+		w.LoadAlternateHTML(s.errorPage(page), page.URI, "")
+		return
+	}
+	time.AfterFunc(s.delay(page.Crashes), func() {
+		glib.IdleAdd(func() bool {
+			s.mu.Lock()
+			_, ok := s.views[key]
+			s.mu.Unlock()
+			if ok {
+				w.LoadURI(page.URI)
+			}
+			return false
+		})
+	})
+}
+
+// maxCrashes returns the crash limit of the CrashSupervisor.
+func (s *CrashSupervisor) maxCrashes() int {
+	if s.MaxCrashes == 0 {
+		return DefaultMaxCrashes
+	}
+	return s.MaxCrashes
+}
+
+// delay returns the time to wait before reloading a WebView after its
+// nth consecutive crash.
+func (s *CrashSupervisor) delay(n int) time.Duration {
+	d, max := s.InitialDelay, s.MaxDelay
+	if d == 0 {
+		d = DefaultInitialDelay
+	}
+	if max == 0 {
+		max = DefaultMaxDelay
+	}
+	for i := 1; i < n && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
+
+// minimalCrashPage is the error page shown if no template can be
+// executed.
+const minimalCrashPage = `<!DOCTYPE html>
+<html><head><title>Page crashed</title></head>
+<body><h1>This page could not be displayed</h1></body></html>
+`
+
+// errorPage returns the HTML of the error page for page.  If the
+// ErrorPage template fails, the error is reported to OnError and the
+// default page is used.
+func (s *CrashSupervisor) errorPage(page CrashPage) string {
+	var b bytes.Buffer
+	if s.ErrorPage != nil {
+		err := s.ErrorPage.Execute(&b, page)
+		if err == nil {
+			return b.String()
+		}
+		s.reportError(err)
+		b.Reset()
+	}
+	if err := defaultCrashPage.Execute(&b, page); err != nil {
+		s.reportError(err)
+		return minimalCrashPage
+	}
+	return b.String()
+}
+
+// reportError passes err to the OnError callback, if set.
+func (s *CrashSupervisor) reportError(err error) {
+	if s.OnError != nil {
+		s.OnError(err)
+	}
+}
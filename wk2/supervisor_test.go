@@ -0,0 +1,83 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCrashSupervisorDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial time.Duration
+		max     time.Duration
+		n       int
+		delay   time.Duration
+	}{
+		{"default first", 0, 0, 1, time.Second},
+		{"default second", 0, 0, 2, 2 * time.Second},
+		{"default third", 0, 0, 3, 4 * time.Second},
+		{"default capped", 0, 0, 7, time.Minute},
+		{"default far past cap", 0, 0, 100, time.Minute},
+		{"custom initial", 500 * time.Millisecond, 0, 3, 2 * time.Second},
+		{"custom max", 0, 3 * time.Second, 3, 3 * time.Second},
+		{"initial above max", 10 * time.Second, 5 * time.Second, 1, 5 * time.Second},
+	}
+	for _, test := range tests {
+		s := &CrashSupervisor{InitialDelay: test.initial, MaxDelay: test.max}
+		if d := s.delay(test.n); d != test.delay {
+			t.Errorf("%s: delay(%d) = %v, want %v", test.name, test.n,
+				d, test.delay)
+		}
+	}
+}
+
+func TestCrashSupervisorMaxCrashes(t *testing.T) {
+	if n := new(CrashSupervisor).maxCrashes(); n != DefaultMaxCrashes {
+		t.Errorf("default max crashes is %d, want %d", n,
+			DefaultMaxCrashes)
+	}
+	s := &CrashSupervisor{MaxCrashes: 2}
+	if n := s.maxCrashes(); n != 2 {
+		t.Errorf("max crashes is %d, want 2", n)
+	}
+}
+
+func TestCrashSupervisorErrorPage(t *testing.T) {
+	custom := template.Must(template.New("custom").Parse(`crashed {{.Crashes}}`))
+	failing := template.Must(template.New("failing").Parse(`{{.Missing}}`))
+
+	tests := []struct {
+		name     string
+		tmpl     *template.Template
+		page     CrashPage
+		contains string
+		errors   int
+	}{
+		{"default", nil, CrashPage{"https://example.com/", 5}, "crashed 5 times", 0},
+		{"default without uri", nil, CrashPage{}, "crashed before it finished loading", 0},
+		{"custom", custom, CrashPage{"https://example.com/", 3}, "crashed 3", 0},
+		{"failing custom", failing, CrashPage{"https://example.com/", 5}, "crashed 5 times", 1},
+	}
+	for _, test := range tests {
+		var errs []error
+		s := &CrashSupervisor{
+			ErrorPage: test.tmpl,
+			OnError:   func(err error) { errs = append(errs, err) },
+		}
+		html := s.errorPage(test.page)
+		if !strings.Contains(html, test.contains) {
+			t.Errorf("%s: page %q does not contain %q", test.name, html,
+				test.contains)
+		}
+		if len(errs) != test.errors {
+			t.Errorf("%s: reported errors %v, want %d", test.name, errs,
+				test.errors)
+		}
+	}
+}
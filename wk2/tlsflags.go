@@ -0,0 +1,43 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import (
+	"fmt"
+	"strings"
+)
+
+var tlsCertificateFlagStrings = []struct {
+	flag TLSCertificateFlags
+	s    string
+}{
+	{TLSCertificateUnknownCA, "UnknownCA"},
+	{TLSCertificateBadIdentity, "BadIdentity"},
+	{TLSCertificateNotActivated, "NotActivated"},
+	{TLSCertificateExpired, "Expired"},
+	{TLSCertificateRevoked, "Revoked"},
+	{TLSCertificateInsecure, "Insecure"},
+	{TLSCertificateGenericError, "GenericError"},
+}
+
+// String returns the names of each set flag, separated by a '|'.  Bits
+// without a name are printed in hexadecimal.
+func (f TLSCertificateFlags) String() string {
+	if f == 0 {
+		return "0"
+	}
+
+	var names []string
+	for _, fs := range tlsCertificateFlagStrings {
+		if f&fs.flag != 0 {
+			names = append(names, fs.s)
+			f &^= fs.flag
+		}
+	}
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint(f)))
+	}
+	return strings.Join(names, "|")
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import "testing"
+
+func TestTLSCertificateFlagsString(t *testing.T) {
+	tests := []struct {
+		flags TLSCertificateFlags
+		want  string
+	}{
+		{0, "0"},
+		{TLSCertificateUnknownCA, "UnknownCA"},
+		{TLSCertificateUnknownCA | TLSCertificateExpired, "UnknownCA|Expired"},
+		{TLSCertificateValidateAll, "UnknownCA|BadIdentity|NotActivated|Expired|Revoked|Insecure|GenericError"},
+		{1 << 8, "0x100"},
+		{TLSCertificateExpired | 1<<8, "Expired|0x100"},
+	}
+	for _, test := range tests {
+		if got := test.flags.String(); got != test.want {
+			t.Errorf("TLSCertificateFlags(0x%x).String() = %q, want %q",
+				uint(test.flags), got, test.want)
+		}
+	}
+}
@@ -3,6 +3,11 @@
 // license that can be found in the LICENSE file.
 
 // Package wk2 provides WebKit2GTK+ bindings for Go.
+//
+// Methods taking a context.Context wait for an asynchronous operation to
+// complete on the GTK+ main loop, or for the context to be done.  They
+// must not be called from the goroutine running the main loop, and return
+// an error if they are.
 package wk2
 
 // #cgo pkg-config: webkit2gtk-3.0
@@ -12,11 +17,25 @@ package wk2
 // #include <string.h>
 //
 // #include <webkit2/webkit2.h>
+// #include <JavaScriptCore/JavaScript.h>
 //
 // #include "webkit2.go.h"
 import "C"
 import (
+	"context"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"os"
 	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
 	"unsafe"
 
 	"github.com/conformal/gotk3/glib"
@@ -26,25 +45,56 @@ import (
 func init() {
 	tm := []glib.TypeMarshaler{
 		// Enums
+		{glib.Type(C.webkit_authentication_scheme_get_type()), marshalAuthenticationScheme},
 		{glib.Type(C.webkit_cache_model_get_type()), marshalCacheModel},
+		{glib.Type(C.webkit_context_menu_action_get_type()), marshalContextMenuAction},
+		{glib.Type(C.webkit_credential_persistence_get_type()), marshalCredentialPersistence},
+		{glib.Type(C.webkit_find_options_get_type()), marshalFindOptions},
+		{glib.Type(C.webkit_hit_test_result_context_get_type()), marshalHitTestResultContext},
+		{glib.Type(C.webkit_insecure_content_event_get_type()), marshalInsecureContentEvent},
 		{glib.Type(C.webkit_load_event_get_type()), marshalLoadEvent},
+		{glib.Type(C.webkit_navigation_type_get_type()), marshalNavigationType},
+		{glib.Type(C.webkit_policy_decision_type_get_type()), marshalPolicyDecisionType},
 		{glib.Type(C.webkit_process_model_get_type()), marshalProcessModel},
+		{glib.Type(C.webkit_save_mode_get_type()), marshalSaveMode},
+		{glib.Type(C.webkit_script_dialog_type_get_type()), marshalScriptDialogType},
+		{glib.Type(C.webkit_snapshot_options_get_type()), marshalSnapshotOptions},
+		{glib.Type(C.webkit_snapshot_region_get_type()), marshalSnapshotRegion},
 		{glib.Type(C.webkit_tls_errors_policy_get_type()), marshalTLSErrorsPolicy},
 
 		// Objects/Interfaces
+		{glib.Type(C.webkit_authentication_request_get_type()), marshalAuthenticationRequest},
 		{glib.Type(C.webkit_back_forward_list_get_type()), marshalBackForwardList},
 		{glib.Type(C.webkit_back_forward_list_item_get_type()), marshalBackForwardListItem},
+		{glib.Type(C.webkit_context_menu_get_type()), marshalContextMenu},
+		{glib.Type(C.webkit_context_menu_item_get_type()), marshalContextMenuItem},
 		{glib.Type(C.webkit_cookie_manager_get_type()), marshalCookieManager},
 		{glib.Type(C.webkit_download_get_type()), marshalDownload},
 		{glib.Type(C.webkit_favicon_database_get_type()), marshalFaviconDatabase},
+		{glib.Type(C.webkit_file_chooser_request_get_type()), marshalFileChooserRequest},
+		{glib.Type(C.webkit_find_controller_get_type()), marshalFindController},
+		{glib.Type(C.webkit_geolocation_permission_request_get_type()), marshalGeolocationPermissionRequest},
+		{glib.Type(C.webkit_hit_test_result_get_type()), marshalHitTestResult},
+		{glib.Type(C.webkit_navigation_policy_decision_get_type()), marshalNavigationPolicyDecision},
+		{glib.Type(C.webkit_permission_request_get_type()), marshalPermissionRequest},
+		{glib.Type(C.webkit_policy_decision_get_type()), marshalPolicyDecision},
+		{glib.Type(C.webkit_response_policy_decision_get_type()), marshalResponsePolicyDecision},
 		{glib.Type(C.webkit_security_manager_get_type()), marshalSecurityManager},
+		{glib.Type(C.webkit_settings_get_type()), marshalSettings},
+		{glib.Type(C.g_tls_certificate_get_type()), marshalTLSCertificate},
 		{glib.Type(C.webkit_uri_request_get_type()), marshalURIRequest},
+		{glib.Type(C.webkit_uri_response_get_type()), marshalURIResponse},
 		{glib.Type(C.webkit_web_context_get_type()), marshalWebContext},
+		{glib.Type(C.webkit_web_inspector_get_type()), marshalWebInspector},
+		{glib.Type(C.webkit_web_resource_get_type()), marshalWebResource},
 		{glib.Type(C.webkit_web_view_get_type()), marshalWebView},
 		{glib.Type(C.webkit_web_view_group_get_type()), marshalWebViewGroup},
+		{glib.Type(C.webkit_window_properties_get_type()), marshalWindowProperties},
 
 		// Boxed
 		{glib.Type(C.webkit_certificate_info_get_type()), marshalCertificateInfo},
+		{glib.Type(C.webkit_credential_get_type()), marshalCredential},
+		{glib.Type(C.webkit_script_dialog_get_type()), marshalScriptDialog},
 	}
 	glib.RegisterGValueMarshalers(tm)
 }
@@ -60,10 +110,313 @@ func gobool(b C.gboolean) bool {
 	return b != 0
 }
 
+// goError returns a Go error with the message of err, and frees err.
+func goError(err *C.GError) error {
+	defer C.g_error_free(err)
+	return errors.New(C.GoString((*C.char)(err.message)))
+}
+
+// convertToUTF8 is a wrapper around g_convert(), converting b from the
+// character set charset to a UTF-8 string.
+func convertToUTF8(b []byte, charset string) (string, error) {
+	if len(b) == 0 {
+		return "", nil
+	}
+	cTo := C.CString("UTF-8")
+	cFrom := C.CString(charset)
+	defer C.free(unsafe.Pointer(cTo))
+	defer C.free(unsafe.Pointer(cFrom))
+
+	var written C.gsize
+	var gerr *C.GError
+	c := C.g_convert((*C.gchar)(unsafe.Pointer(&b[0])), C.gssize(len(b)),
+		(*C.gchar)(cTo), (*C.gchar)(cFrom), nil, &written, &gerr)
+	if c == nil {
+		return "", goError(gerr)
+	}
+	defer C.g_free(C.gpointer(unsafe.Pointer(c)))
+	return C.GoStringN((*C.char)(c), C.int(written)), nil
+}
+
+// asyncCallbacks holds the Go callbacks of pending asynchronous
+// operations, keyed by the ID passed as the GAsyncReadyCallback user
+// data.
+var asyncCallbacks = struct {
+	sync.Mutex
+	m    map[uint]func(*C.GAsyncResult)
+	next uint
+}{
+	m: make(map[uint]func(*C.GAsyncResult)),
+}
+
+//export goAsyncReadyCallback
+func goAsyncReadyCallback(source *C.GObject, res *C.GAsyncResult, data C.gpointer) {
+	id := uint(C.gpointerToUint(data))
+
+	asyncCallbacks.Lock()
+	f := asyncCallbacks.m[id]
+	delete(asyncCallbacks.m, id)
+	asyncCallbacks.Unlock()
+
+	if f != nil {
+		f(res)
+	}
+}
+
+// errMainLoop is returned by functions which wait on the GLib main loop
+// when called from the goroutine running it, as they would never return.
+var errMainLoop = errors.New("wk2: blocking call made from the GTK+ main loop")
+
+// onMainLoop returns whether the calling goroutine is running the default
+// GLib main loop, which it must own to dispatch its sources.
+func onMainLoop() bool {
+	return gobool(C.g_main_context_is_owner(C.g_main_context_default()))
+}
+
+// runAsync starts an asynchronous operation on the GLib main loop and
+// waits for it to complete.  start must begin the operation, passing the
+// cancellable, callback and user data it is given to the WebKit2GTK+
+// async function.  finish is run from the main loop with the operation's
+// result, and its error is returned.  If ctx is done before the operation
+// completes, the operation is cancelled and ctx.Err() is returned.  If
+// ctx is done before the operation is started, start is never run.  As
+// start and finish may run after runAsync returns, they must not use C
+// memory freed by the caller.
+//
+// As the result is delivered by the main loop, runAsync must not be
+// called from the goroutine running the main loop, and returns
+// errMainLoop if it is.
+func runAsync(ctx context.Context,
+	start func(*C.GCancellable, C.GAsyncReadyCallback, C.gpointer),
+	finish func(*C.GAsyncResult) error) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if onMainLoop() {
+		return errMainLoop
+	}
+
+	// The cancellable is referenced twice: once by runAsync, released
+	// when it returns, and once by the main loop, released when the
+	// operation completes or is skipped.  Neither may free it while the
+	// other still uses it.
+	cancellable := C.g_cancellable_new()
+	C.g_object_ref(C.gpointer(unsafe.Pointer(cancellable)))
+	defer C.g_object_unref(C.gpointer(unsafe.Pointer(cancellable)))
+
+	done := make(chan error, 1)
+	asyncCallbacks.Lock()
+	id := asyncCallbacks.next
+	asyncCallbacks.next++
+	asyncCallbacks.m[id] = func(res *C.GAsyncResult) {
+		defer C.g_object_unref(C.gpointer(unsafe.Pointer(cancellable)))
+		done <- finish(res)
+	}
+	asyncCallbacks.Unlock()
+
+	skip := func() {
+		asyncCallbacks.Lock()
+		delete(asyncCallbacks.m, id)
+		asyncCallbacks.Unlock()
+		C.g_object_unref(C.gpointer(unsafe.Pointer(cancellable)))
+	}
+	_, err := glib.IdleAdd(func() bool {
+		if ctx.Err() != nil {
+			skip()
+			return false
+		}
+		start(cancellable, C.asyncReadyCallback(),
+			C.uintToGpointer(C.guint(id)))
+		return false
+	})
+	if err != nil {
+		skip()
+		return err
+	}
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		C.g_cancellable_cancel(cancellable)
+		return ctx.Err()
+	}
+}
+
+// signalHandlers holds Go signal handlers connected with a C callback
+// rather than glib.Object.Connect, keyed by the ID passed as the
+// callback's user data.  Handlers are removed when the signal handler is
+// disconnected or its instance is finalized.
+var signalHandlers = struct {
+	sync.Mutex
+	m    map[uint]interface{}
+	next uint
+}{
+	m: make(map[uint]interface{}),
+}
+
+// registerSignalHandler saves f in signalHandlers and returns its ID.
+func registerSignalHandler(f interface{}) uint {
+	signalHandlers.Lock()
+	id := signalHandlers.next
+	signalHandlers.next++
+	signalHandlers.m[id] = f
+	signalHandlers.Unlock()
+	return id
+}
+
+// signalHandler returns the handler saved in signalHandlers with the ID
+// held by data.
+func signalHandler(data C.gpointer) interface{} {
+	id := uint(C.gpointerToUint(data))
+	signalHandlers.Lock()
+	f := signalHandlers.m[id]
+	signalHandlers.Unlock()
+	return f
+}
+
+//export goSignalHandlerDestroy
+func goSignalHandlerDestroy(data C.gpointer, closure *C.GClosure) {
+	id := uint(C.gpointerToUint(data))
+	signalHandlers.Lock()
+	delete(signalHandlers.m, id)
+	signalHandlers.Unlock()
+}
+
+// imageSurfaceRGBA copies the pixels of a cairo image surface to a new
+// image.RGBA.  Only surfaces with the ARGB32 or RGB24 formats are
+// supported.
+func imageSurfaceRGBA(surface *C.cairo_surface_t) (*image.RGBA, error) {
+	if C.cairo_surface_get_type(surface) != C.CAIRO_SURFACE_TYPE_IMAGE {
+		return nil, errors.New("wk2: cairo surface is not an image surface")
+	}
+	var opaque bool
+	switch C.cairo_image_surface_get_format(surface) {
+	case C.CAIRO_FORMAT_ARGB32:
+	case C.CAIRO_FORMAT_RGB24:
+		opaque = true
+	default:
+		return nil, errors.New("wk2: unsupported cairo image format")
+	}
+
+	C.cairo_surface_flush(surface)
+	width := int(C.cairo_image_surface_get_width(surface))
+	height := int(C.cairo_image_surface_get_height(surface))
+	stride := int(C.cairo_image_surface_get_stride(surface))
+	data := C.cairo_image_surface_get_data(surface)
+	if data == nil {
+		return nil, errors.New("wk2: cairo image surface has no data")
+	}
+	pix := C.GoBytes(unsafe.Pointer(data), C.int(stride*height))
+	return argb32ToRGBA(pix, width, height, stride, opaque), nil
+}
+
+// nativeEndian is the byte order of the host, in which cairo stores the
+// 32-bit words of ARGB32 pixels.
+var nativeEndian = func() binary.ByteOrder {
+	x := uint16(1)
+	if *(*byte)(unsafe.Pointer(&x)) == 1 {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}()
+
+// argb32ToRGBA converts pixels in cairo's ARGB32 format, where each pixel
+// is a native-endian 32-bit word with premultiplied alpha, to an
+// image.RGBA.  As image.RGBA is also premultiplied, the color channels
+// are only reordered.  If opaque is set, the pixels are in the RGB24
+// format and the alpha channel is ignored.
+func argb32ToRGBA(pix []byte, width, height, stride int, opaque bool) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		src := pix[y*stride : y*stride+width*4]
+		dst := img.Pix[y*img.Stride : y*img.Stride+width*4]
+		for x := 0; x < width*4; x += 4 {
+			argb := nativeEndian.Uint32(src[x : x+4])
+			dst[x+0] = uint8(argb >> 16)
+			dst[x+1] = uint8(argb >> 8)
+			dst[x+2] = uint8(argb)
+			if opaque {
+				dst[x+3] = 0xff
+			} else {
+				dst[x+3] = uint8(argb >> 24)
+			}
+		}
+	}
+	return img
+}
+
+// inputStream is an io.ReadCloser reading from a GInputStream.  The
+// stream is unreferenced by Close, or when the inputStream is garbage
+// collected.
+type inputStream struct {
+	stream *C.GInputStream
+}
+
+// newInputStream wraps stream, taking ownership of its reference.
+func newInputStream(stream *C.GInputStream) *inputStream {
+	s := &inputStream{stream}
+	runtime.SetFinalizer(s, (*inputStream).Close)
+	return s
+}
+
+// Read is a wrapper around g_input_stream_read().  As the read blocks, the
+// GInputStream should not be one read by the GLib main loop.
+func (s *inputStream) Read(p []byte) (int, error) {
+	if s.stream == nil {
+		return 0, errors.New("wk2: read of closed stream")
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	var gerr *C.GError
+	n := C.g_input_stream_read(s.stream, unsafe.Pointer(&p[0]),
+		C.gsize(len(p)), nil, &gerr)
+	switch {
+	case n < 0:
+		return 0, goError(gerr)
+	case n == 0:
+		return 0, io.EOF
+	}
+	return int(n), nil
+}
+
+// Close unreferences the GInputStream.
+func (s *inputStream) Close() error {
+	if s.stream != nil {
+		C.g_object_unref(C.gpointer(unsafe.Pointer(s.stream)))
+		s.stream = nil
+	}
+	return nil
+}
+
 //
 // Constants
 //
 
+// AuthenticationScheme is a representation of WebKit2GTK+'s
+// WebKitAuthenticationScheme.
+type AuthenticationScheme int
+
+// These constants define the scheme of an AuthenticationRequest.
+const (
+	AuthenticationSchemeDefault                        AuthenticationScheme = C.WEBKIT_AUTHENTICATION_SCHEME_DEFAULT
+	AuthenticationSchemeHTTPBasic                      AuthenticationScheme = C.WEBKIT_AUTHENTICATION_SCHEME_HTTP_BASIC
+	AuthenticationSchemeHTTPDigest                     AuthenticationScheme = C.WEBKIT_AUTHENTICATION_SCHEME_HTTP_DIGEST
+	AuthenticationSchemeHTMLForm                       AuthenticationScheme = C.WEBKIT_AUTHENTICATION_SCHEME_HTML_FORM
+	AuthenticationSchemeNTLM                           AuthenticationScheme = C.WEBKIT_AUTHENTICATION_SCHEME_NTLM
+	AuthenticationSchemeNegotiate                      AuthenticationScheme = C.WEBKIT_AUTHENTICATION_SCHEME_NEGOTIATE
+	AuthenticationSchemeClientCertificateRequested     AuthenticationScheme = C.WEBKIT_AUTHENTICATION_SCHEME_CLIENT_CERTIFICATE_REQUESTED
+	AuthenticationSchemeServerTrustEvaluationRequested AuthenticationScheme = C.WEBKIT_AUTHENTICATION_SCHEME_SERVER_TRUST_EVALUATION_REQUESTED
+	AuthenticationSchemeUnknown                        AuthenticationScheme = C.WEBKIT_AUTHENTICATION_SCHEME_UNKNOWN
+)
+
+func marshalAuthenticationScheme(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return AuthenticationScheme(c), nil
+}
+
 // CacheModel is a representation of WebKit2GTK's WebKitCacheModel.
 type CacheModel int
 
@@ -79,6 +432,151 @@ func marshalCacheModel(p uintptr) (interface{}, error) {
 	return CacheModel(c), nil
 }
 
+// ContextMenuAction is a representation of WebKit2GTK+'s
+// WebKitContextMenuAction.
+type ContextMenuAction int
+
+// These constants define the stock actions of context menu items.
+const (
+	ContextMenuActionNoAction                 ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_NO_ACTION
+	ContextMenuActionOpenLink                 ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_OPEN_LINK
+	ContextMenuActionOpenLinkInNewWindow      ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_OPEN_LINK_IN_NEW_WINDOW
+	ContextMenuActionDownloadLinkToDisk       ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_DOWNLOAD_LINK_TO_DISK
+	ContextMenuActionCopyLinkToClipboard      ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_COPY_LINK_TO_CLIPBOARD
+	ContextMenuActionOpenImageInNewWindow     ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_OPEN_IMAGE_IN_NEW_WINDOW
+	ContextMenuActionDownloadImageToDisk      ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_DOWNLOAD_IMAGE_TO_DISK
+	ContextMenuActionCopyImageToClipboard     ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_COPY_IMAGE_TO_CLIPBOARD
+	ContextMenuActionCopyImageURLToClipboard  ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_COPY_IMAGE_URL_TO_CLIPBOARD
+	ContextMenuActionOpenFrameInNewWindow     ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_OPEN_FRAME_IN_NEW_WINDOW
+	ContextMenuActionGoBack                   ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_GO_BACK
+	ContextMenuActionGoForward                ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_GO_FORWARD
+	ContextMenuActionStop                     ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_STOP
+	ContextMenuActionReload                   ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_RELOAD
+	ContextMenuActionCopy                     ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_COPY
+	ContextMenuActionCut                      ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_CUT
+	ContextMenuActionPaste                    ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_PASTE
+	ContextMenuActionDelete                   ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_DELETE
+	ContextMenuActionSelectAll                ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_SELECT_ALL
+	ContextMenuActionInputMethods             ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_INPUT_METHODS
+	ContextMenuActionUnicode                  ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_UNICODE
+	ContextMenuActionSpellingGuess            ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_SPELLING_GUESS
+	ContextMenuActionNoGuessesFound           ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_NO_GUESSES_FOUND
+	ContextMenuActionIgnoreSpelling           ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_IGNORE_SPELLING
+	ContextMenuActionLearnSpelling            ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_LEARN_SPELLING
+	ContextMenuActionIgnoreGrammar            ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_IGNORE_GRAMMAR
+	ContextMenuActionFontMenu                 ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_FONT_MENU
+	ContextMenuActionBold                     ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_BOLD
+	ContextMenuActionItalic                   ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_ITALIC
+	ContextMenuActionUnderline                ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_UNDERLINE
+	ContextMenuActionOutline                  ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_OUTLINE
+	ContextMenuActionInspectElement           ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_INSPECT_ELEMENT
+	ContextMenuActionOpenVideoInNewWindow     ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_OPEN_VIDEO_IN_NEW_WINDOW
+	ContextMenuActionOpenAudioInNewWindow     ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_OPEN_AUDIO_IN_NEW_WINDOW
+	ContextMenuActionCopyVideoLinkToClipboard ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_COPY_VIDEO_LINK_TO_CLIPBOARD
+	ContextMenuActionCopyAudioLinkToClipboard ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_COPY_AUDIO_LINK_TO_CLIPBOARD
+	ContextMenuActionToggleMediaControls      ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_TOGGLE_MEDIA_CONTROLS
+	ContextMenuActionToggleMediaLoop          ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_TOGGLE_MEDIA_LOOP
+	ContextMenuActionEnterVideoFullscreen     ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_ENTER_VIDEO_FULLSCREEN
+	ContextMenuActionMediaPlay                ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_MEDIA_PLAY
+	ContextMenuActionMediaPause               ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_MEDIA_PAUSE
+	ContextMenuActionMediaMute                ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_MEDIA_MUTE
+	ContextMenuActionDownloadVideoToDisk      ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_DOWNLOAD_VIDEO_TO_DISK
+	ContextMenuActionDownloadAudioToDisk      ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_DOWNLOAD_AUDIO_TO_DISK
+	ContextMenuActionCustom                   ContextMenuAction = C.WEBKIT_CONTEXT_MENU_ACTION_CUSTOM
+)
+
+func marshalContextMenuAction(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return ContextMenuAction(c), nil
+}
+
+// CredentialPersistence is a representation of WebKit2GTK+'s
+// WebKitCredentialPersistence.
+type CredentialPersistence int
+
+// These constants define how long a Credential is remembered.
+const (
+	CredentialPersistenceNone       CredentialPersistence = C.WEBKIT_CREDENTIAL_PERSISTENCE_NONE
+	CredentialPersistenceForSession CredentialPersistence = C.WEBKIT_CREDENTIAL_PERSISTENCE_FOR_SESSION
+	CredentialPersistencePermanent  CredentialPersistence = C.WEBKIT_CREDENTIAL_PERSISTENCE_PERMANENT
+)
+
+func marshalCredentialPersistence(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return CredentialPersistence(c), nil
+}
+
+// EditingCommand is a command which may be executed by a WebView's
+// editor.
+type EditingCommand string
+
+// These constants define the editing commands of WebKit2GTK+, as
+// described by the WEBKIT_EDITING_COMMAND_* macros.
+const (
+	EditingCommandCut       EditingCommand = "Cut"
+	EditingCommandCopy      EditingCommand = "Copy"
+	EditingCommandPaste     EditingCommand = "Paste"
+	EditingCommandSelectAll EditingCommand = "SelectAll"
+	EditingCommandUndo      EditingCommand = "Undo"
+	EditingCommandRedo      EditingCommand = "Redo"
+)
+
+// FindOptions is a representation of WebKit2GTK+'s WebKitFindOptions.
+type FindOptions uint32
+
+// These flags define the options of a FindController search.
+const (
+	FindOptionsNone                          FindOptions = C.WEBKIT_FIND_OPTIONS_NONE
+	FindOptionsCaseInsensitive               FindOptions = C.WEBKIT_FIND_OPTIONS_CASE_INSENSITIVE
+	FindOptionsAtWordStarts                  FindOptions = C.WEBKIT_FIND_OPTIONS_AT_WORD_STARTS
+	FindOptionsTreatMedialCapitalAsWordStart FindOptions = C.WEBKIT_FIND_OPTIONS_TREAT_MEDIAL_CAPITAL_AS_WORD_START
+	FindOptionsBackwards                     FindOptions = C.WEBKIT_FIND_OPTIONS_BACKWARDS
+	FindOptionsWrapAround                    FindOptions = C.WEBKIT_FIND_OPTIONS_WRAP_AROUND
+)
+
+func marshalFindOptions(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return FindOptions(c), nil
+}
+
+// HitTestResultContext is a representation of WebKit2GTK+'s
+// WebKitHitTestResultContext.
+type HitTestResultContext uint
+
+// These flags define the kinds of element found by a hit test.
+// WebKit2GTK+ 2.4 does not report whether a hit test found selected
+// text; WEBKIT_HIT_TEST_RESULT_CONTEXT_SELECTION was added in WebKit2GTK+
+// 2.8, so there is no HitTestResultContextSelection.
+const (
+	HitTestResultContextDocument  HitTestResultContext = C.WEBKIT_HIT_TEST_RESULT_CONTEXT_DOCUMENT
+	HitTestResultContextLink      HitTestResultContext = C.WEBKIT_HIT_TEST_RESULT_CONTEXT_LINK
+	HitTestResultContextImage     HitTestResultContext = C.WEBKIT_HIT_TEST_RESULT_CONTEXT_IMAGE
+	HitTestResultContextMedia     HitTestResultContext = C.WEBKIT_HIT_TEST_RESULT_CONTEXT_MEDIA
+	HitTestResultContextEditable  HitTestResultContext = C.WEBKIT_HIT_TEST_RESULT_CONTEXT_EDITABLE
+	HitTestResultContextScrollbar HitTestResultContext = C.WEBKIT_HIT_TEST_RESULT_CONTEXT_SCROLLBAR
+)
+
+func marshalHitTestResultContext(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return HitTestResultContext(c), nil
+}
+
+// InsecureContentEvent is a representation of WebKit2GTK+'s
+// WebKitInsecureContentEvent.
+type InsecureContentEvent int
+
+// These constants define how insecure content was used by a page loaded
+// over HTTPS.
+const (
+	InsecureContentRun       InsecureContentEvent = C.WEBKIT_INSECURE_CONTENT_RUN
+	InsecureContentDisplayed InsecureContentEvent = C.WEBKIT_INSECURE_CONTENT_DISPLAYED
+)
+
+func marshalInsecureContentEvent(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return InsecureContentEvent(c), nil
+}
+
 // LoadEvent is a representation of WebKit2GTK+'s WebKitLoadEvent.
 type LoadEvent int
 
@@ -96,6 +594,41 @@ func marshalLoadEvent(p uintptr) (interface{}, error) {
 	return LoadEvent(c), nil
 }
 
+// NavigationType is a representation of WebKit2GTK+'s WebKitNavigationType.
+type NavigationType int
+
+// These constants define the type of navigation that triggered a
+// NavigationPolicyDecision.
+const (
+	NavigationTypeLinkClicked     NavigationType = C.WEBKIT_NAVIGATION_TYPE_LINK_CLICKED
+	NavigationTypeFormSubmitted   NavigationType = C.WEBKIT_NAVIGATION_TYPE_FORM_SUBMITTED
+	NavigationTypeBackForward     NavigationType = C.WEBKIT_NAVIGATION_TYPE_BACK_FORWARD
+	NavigationTypeReload          NavigationType = C.WEBKIT_NAVIGATION_TYPE_RELOAD
+	NavigationTypeFormResubmitted NavigationType = C.WEBKIT_NAVIGATION_TYPE_FORM_RESUBMITTED
+	NavigationTypeOther           NavigationType = C.WEBKIT_NAVIGATION_TYPE_OTHER
+)
+
+func marshalNavigationType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return NavigationType(c), nil
+}
+
+// PolicyDecisionType is a representation of WebKit2GTK+'s
+// WebKitPolicyDecisionType.
+type PolicyDecisionType int
+
+// These constants define the kind of policy decision being made.
+const (
+	PolicyDecisionTypeNavigationAction PolicyDecisionType = C.WEBKIT_POLICY_DECISION_TYPE_NAVIGATION_ACTION
+	PolicyDecisionTypeNewWindowAction  PolicyDecisionType = C.WEBKIT_POLICY_DECISION_TYPE_NEW_WINDOW_ACTION
+	PolicyDecisionTypeResponse         PolicyDecisionType = C.WEBKIT_POLICY_DECISION_TYPE_RESPONSE
+)
+
+func marshalPolicyDecisionType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return PolicyDecisionType(c), nil
+}
+
 // ProcessModel is a representation of WebKit2GTK+'s WebKitProcessModel.
 type ProcessModel int
 
@@ -110,6 +643,70 @@ func marshalProcessModel(p uintptr) (interface{}, error) {
 	return ProcessModel(c), nil
 }
 
+// SaveMode is a representation of WebKit2GTK+'s WebKitSaveMode.
+type SaveMode int
+
+// These constants define the format used to save a WebView's page.
+const (
+	SaveModeMHTML SaveMode = C.WEBKIT_SAVE_MODE_MHTML
+)
+
+func marshalSaveMode(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return SaveMode(c), nil
+}
+
+// ScriptDialogType is a representation of WebKit2GTK+'s
+// WebKitScriptDialogType.
+type ScriptDialogType int
+
+// These constants define the JavaScript function which requested a
+// ScriptDialog.  WebKit2GTK+ 2.4 does not emit script-dialog for
+// beforeunload; WEBKIT_SCRIPT_DIALOG_BEFORE_UNLOAD_CONFIRM was added in
+// WebKit2GTK+ 2.12, so onbeforeunload confirmations can not be answered.
+const (
+	ScriptDialogAlert   ScriptDialogType = C.WEBKIT_SCRIPT_DIALOG_ALERT
+	ScriptDialogConfirm ScriptDialogType = C.WEBKIT_SCRIPT_DIALOG_CONFIRM
+	ScriptDialogPrompt  ScriptDialogType = C.WEBKIT_SCRIPT_DIALOG_PROMPT
+)
+
+func marshalScriptDialogType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return ScriptDialogType(c), nil
+}
+
+// SnapshotOptions is a representation of WebKit2GTK+'s
+// WebKitSnapshotOptions.
+type SnapshotOptions uint
+
+// These flags define the options of a WebView snapshot.  Snapshots always
+// include the page background in WebKit2GTK+ 2.4;
+// WEBKIT_SNAPSHOT_OPTIONS_TRANSPARENT_BACKGROUND was added in WebKit2GTK+
+// 2.8, so there is no SnapshotOptionsTransparentBackground.
+const (
+	SnapshotOptionsNone                         SnapshotOptions = C.WEBKIT_SNAPSHOT_OPTIONS_NONE
+	SnapshotOptionsIncludeSelectionHighlighting SnapshotOptions = C.WEBKIT_SNAPSHOT_OPTIONS_INCLUDE_SELECTION_HIGHLIGHTING
+)
+
+func marshalSnapshotOptions(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return SnapshotOptions(c), nil
+}
+
+// SnapshotRegion is a representation of WebKit2GTK+'s WebKitSnapshotRegion.
+type SnapshotRegion int
+
+// These constants define the region of a WebView snapshot.
+const (
+	SnapshotRegionVisible      SnapshotRegion = C.WEBKIT_SNAPSHOT_REGION_VISIBLE
+	SnapshotRegionFullDocument SnapshotRegion = C.WEBKIT_SNAPSHOT_REGION_FULL_DOCUMENT
+)
+
+func marshalSnapshotRegion(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return SnapshotRegion(c), nil
+}
+
 // TLSErrorsPolicy is a representation of WebKit2GTK+'s WebKitTLSErrorsPolicy.
 type TLSErrorsPolicy int
 
@@ -124,6 +721,128 @@ func marshalTLSErrorsPolicy(p uintptr) (interface{}, error) {
 	return TLSErrorsPolicy(c), nil
 }
 
+// TLSCertificateFlags is a representation of GIO's GTlsCertificateFlags.
+type TLSCertificateFlags uint
+
+// These flags describe the reasons a TLS certificate failed validation.
+const (
+	TLSCertificateUnknownCA    TLSCertificateFlags = C.G_TLS_CERTIFICATE_UNKNOWN_CA
+	TLSCertificateBadIdentity  TLSCertificateFlags = C.G_TLS_CERTIFICATE_BAD_IDENTITY
+	TLSCertificateNotActivated TLSCertificateFlags = C.G_TLS_CERTIFICATE_NOT_ACTIVATED
+	TLSCertificateExpired      TLSCertificateFlags = C.G_TLS_CERTIFICATE_EXPIRED
+	TLSCertificateRevoked      TLSCertificateFlags = C.G_TLS_CERTIFICATE_REVOKED
+	TLSCertificateInsecure     TLSCertificateFlags = C.G_TLS_CERTIFICATE_INSECURE
+	TLSCertificateGenericError TLSCertificateFlags = C.G_TLS_CERTIFICATE_GENERIC_ERROR
+	TLSCertificateValidateAll  TLSCertificateFlags = C.G_TLS_CERTIFICATE_VALIDATE_ALL
+)
+
+//
+// WebKitAuthenticationRequest
+//
+
+// AuthenticationRequest is a representation of WebKit2GTK+'s
+// WebKitAuthenticationRequest.
+type AuthenticationRequest struct {
+	*glib.Object
+}
+
+func marshalAuthenticationRequest(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapAuthenticationRequest(obj), nil
+}
+
+func wrapAuthenticationRequest(obj *glib.Object) *AuthenticationRequest {
+	return &AuthenticationRequest{obj}
+}
+
+// native returns a pointer to the underlying WebKitAuthenticationRequest.
+func (r *AuthenticationRequest) native() *C.WebKitAuthenticationRequest {
+	if r == nil || r.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(r.GObject)
+	return C.toWebKitAuthenticationRequest(p)
+}
+
+// CanSaveCredentials is a wrapper around
+// webkit_authentication_request_can_save_credentials().
+func (r *AuthenticationRequest) CanSaveCredentials() bool {
+	c := C.webkit_authentication_request_can_save_credentials(r.native())
+	return gobool(c)
+}
+
+// Host is a wrapper around webkit_authentication_request_get_host().
+func (r *AuthenticationRequest) Host() string {
+	c := C.webkit_authentication_request_get_host(r.native())
+	return C.GoString((*C.char)(c))
+}
+
+// Port is a wrapper around webkit_authentication_request_get_port().
+func (r *AuthenticationRequest) Port() uint {
+	c := C.webkit_authentication_request_get_port(r.native())
+	return uint(c)
+}
+
+// Realm is a wrapper around webkit_authentication_request_get_realm().
+func (r *AuthenticationRequest) Realm() string {
+	c := C.webkit_authentication_request_get_realm(r.native())
+	return C.GoString((*C.char)(c))
+}
+
+// Scheme is a wrapper around webkit_authentication_request_get_scheme().
+func (r *AuthenticationRequest) Scheme() AuthenticationScheme {
+	c := C.webkit_authentication_request_get_scheme(r.native())
+	return AuthenticationScheme(c)
+}
+
+// IsForProxy is a wrapper around
+// webkit_authentication_request_is_for_proxy().
+func (r *AuthenticationRequest) IsForProxy() bool {
+	c := C.webkit_authentication_request_is_for_proxy(r.native())
+	return gobool(c)
+}
+
+// IsRetry is a wrapper around webkit_authentication_request_is_retry().
+func (r *AuthenticationRequest) IsRetry() bool {
+	c := C.webkit_authentication_request_is_retry(r.native())
+	return gobool(c)
+}
+
+// ProposedCredential is a wrapper around
+// webkit_authentication_request_get_proposed_credential().
+func (r *AuthenticationRequest) ProposedCredential() *Credential {
+	c := C.webkit_authentication_request_get_proposed_credential(r.native())
+	if c == nil {
+		return nil
+	}
+	wrapped := wrapCredential(c)
+	runtime.SetFinalizer(wrapped, (*Credential).free)
+	return wrapped
+}
+
+// Authenticate is a wrapper around
+// webkit_authentication_request_authenticate().  A nil credential
+// continues the request without authentication.
+func (r *AuthenticationRequest) Authenticate(credential *Credential) {
+	C.webkit_authentication_request_authenticate(r.native(),
+		credential.native())
+}
+
+// Cancel is a wrapper around webkit_authentication_request_cancel().
+func (r *AuthenticationRequest) Cancel() {
+	C.webkit_authentication_request_cancel(r.native())
+}
+
+// CredentialProvider is the interface implemented by types which provide
+// the credentials of HTTP authentication requests.  Credential returns
+// the credential to authenticate r with.  If ok is false, r is not
+// handled and WebKit's default authentication dialog is shown.  If ok is
+// true and the credential is nil, r is cancelled.
+type CredentialProvider interface {
+	Credential(r *AuthenticationRequest) (credential *Credential, ok bool)
+}
+
 //
 // WebKitBackForwardList
 //
@@ -251,7 +970,9 @@ type CertificateInfo struct {
 
 func marshalCertificateInfo(p uintptr) (interface{}, error) {
 	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
-	info := (*C.WebKitCertificateInfo)(unsafe.Pointer(c))
+	// The boxed value is owned by the GValue, so a copy is wrapped
+	// instead.
+	info := C.webkit_certificate_info_copy((*C.WebKitCertificateInfo)(unsafe.Pointer(c)))
 	wrapped := wrapCertificateInfo(info)
 	runtime.SetFinalizer(wrapped, (*CertificateInfo).free)
 	return wrapped, nil
@@ -279,116 +1000,2276 @@ func (i *CertificateInfo) free() {
 	C.webkit_certificate_info_free(i.native())
 }
 
-//
-// WebKitCookieManager
-//
-
-// CookieManager is a representation of WebKit2GTK+'s WebKitCookieManager.
-type CookieManager struct {
-	*glib.Object
+// TLSCertificate is a wrapper around
+// webkit_certificate_info_get_tls_certificate().
+func (i *CertificateInfo) TLSCertificate() *TLSCertificate {
+	c := C.webkit_certificate_info_get_tls_certificate(i.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapTLSCertificate(obj)
 }
 
-func marshalCookieManager(p uintptr) (interface{}, error) {
-	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
-	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
-	return wrapCookieManager(obj), nil
+// Copy is a wrapper around webkit_certificate_info_copy().
+func (i *CertificateInfo) Copy() *CertificateInfo {
+	c := C.webkit_certificate_info_copy(i.native())
+	if c == nil {
+		return nil
+	}
+	wrapped := wrapCertificateInfo(c)
+	runtime.SetFinalizer(wrapped, (*CertificateInfo).free)
+	return wrapped
 }
 
-func wrapCookieManager(obj *glib.Object) *CookieManager {
-	return &CookieManager{obj}
+// TLSErrors is a wrapper around webkit_certificate_info_get_tls_errors().
+func (i *CertificateInfo) TLSErrors() TLSCertificateFlags {
+	c := C.webkit_certificate_info_get_tls_errors(i.native())
+	return TLSCertificateFlags(c)
 }
 
-// native returns a pointer to the underlying WebKitCookieManager.
-func (m *CookieManager) native() *C.WebKitCookieManager {
-	if m == nil || m.GObject == nil {
-		return nil
+// Certificates returns the certificate chain of the CertificateInfo's
+// TLS certificate, starting with the certificate itself and followed by
+// each issuer known to GIO.
+func (i *CertificateInfo) Certificates() ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+	for c := i.TLSCertificate(); c != nil; c = c.Issuer() {
+		cert, err := c.X509()
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, cert)
 	}
-	p := unsafe.Pointer(m.GObject)
-	return C.toWebKitCookieManager(p)
+	return chain, nil
 }
 
 //
-// WebKitDownload
+// WebKitContextMenu
 //
 
-// Download is a representation of WebKit2GTK+'s WebKitDownload.
-type Download struct {
+// ContextMenu is a representation of WebKit2GTK+'s WebKitContextMenu.
+type ContextMenu struct {
 	*glib.Object
 }
 
-func marshalDownload(p uintptr) (interface{}, error) {
+func marshalContextMenu(p uintptr) (interface{}, error) {
 	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
 	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
-	return wrapDownload(obj), nil
+	return wrapContextMenu(obj), nil
 }
 
-func wrapDownload(obj *glib.Object) *Download {
-	return &Download{obj}
+func wrapContextMenu(obj *glib.Object) *ContextMenu {
+	return &ContextMenu{obj}
 }
 
-// native returns a pointer to the underlying WebKitDownload.
-func (d *Download) native() *C.WebKitDownload {
-	if d == nil || d.GObject == nil {
+// native returns a pointer to the underlying WebKitContextMenu.
+func (m *ContextMenu) native() *C.WebKitContextMenu {
+	if m == nil || m.GObject == nil {
 		return nil
 	}
-	p := unsafe.Pointer(d.GObject)
-	return C.toWebKitDownload(p)
+	p := unsafe.Pointer(m.GObject)
+	return C.toWebKitContextMenu(p)
 }
 
-//
-// WebKitFaviconDatabase
-//
+// NewContextMenu is a wrapper around webkit_context_menu_new().
+func NewContextMenu() *ContextMenu {
+	c := C.webkit_context_menu_new()
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapContextMenu(obj)
+}
 
-// FaviconDatabase is a representation of WebKit2GTK+'s WebKitFaviconDatabase.
-type FaviconDatabase struct {
-	*glib.Object
+// Prepend is a wrapper around webkit_context_menu_prepend().
+func (m *ContextMenu) Prepend(item *ContextMenuItem) {
+	C.webkit_context_menu_prepend(m.native(), item.native())
 }
 
-func marshalFaviconDatabase(p uintptr) (interface{}, error) {
-	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+// Append is a wrapper around webkit_context_menu_append().
+func (m *ContextMenu) Append(item *ContextMenuItem) {
+	C.webkit_context_menu_append(m.native(), item.native())
+}
+
+// Insert is a wrapper around webkit_context_menu_insert().
+func (m *ContextMenu) Insert(item *ContextMenuItem, position int) {
+	C.webkit_context_menu_insert(m.native(), item.native(), C.gint(position))
+}
+
+// MoveItem is a wrapper around webkit_context_menu_move_item().
+func (m *ContextMenu) MoveItem(item *ContextMenuItem, position int) {
+	C.webkit_context_menu_move_item(m.native(), item.native(),
+		C.gint(position))
+}
+
+// Items is a wrapper around webkit_context_menu_get_items().
+func (m *ContextMenu) Items() []*ContextMenuItem {
+	var items []*ContextMenuItem
+	l := C.webkit_context_menu_get_items(m.native())
+	for ; l != nil; l = l.next {
+		obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(l.data))}
+		obj.RefSink()
+		runtime.SetFinalizer(obj, (*glib.Object).Unref)
+		items = append(items, wrapContextMenuItem(obj))
+	}
+	return items
+}
+
+// Len is a wrapper around webkit_context_menu_get_n_items().
+func (m *ContextMenu) Len() uint {
+	c := C.webkit_context_menu_get_n_items(m.native())
+	return uint(c)
+}
+
+// ItemAt is a wrapper around webkit_context_menu_get_item_at_position().
+func (m *ContextMenu) ItemAt(position uint) *ContextMenuItem {
+	c := C.webkit_context_menu_get_item_at_position(m.native(),
+		C.guint(position))
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapContextMenuItem(obj)
+}
+
+// First is a wrapper around webkit_context_menu_first().
+func (m *ContextMenu) First() *ContextMenuItem {
+	c := C.webkit_context_menu_first(m.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapContextMenuItem(obj)
+}
+
+// Last is a wrapper around webkit_context_menu_last().
+func (m *ContextMenu) Last() *ContextMenuItem {
+	c := C.webkit_context_menu_last(m.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapContextMenuItem(obj)
+}
+
+// Remove is a wrapper around webkit_context_menu_remove().
+func (m *ContextMenu) Remove(item *ContextMenuItem) {
+	C.webkit_context_menu_remove(m.native(), item.native())
+}
+
+// RemoveAll is a wrapper around webkit_context_menu_remove_all().
+func (m *ContextMenu) RemoveAll() {
+	C.webkit_context_menu_remove_all(m.native())
+}
+
+//
+// WebKitContextMenuItem
+//
+
+// ContextMenuItem is a representation of WebKit2GTK+'s
+// WebKitContextMenuItem.
+type ContextMenuItem struct {
+	glib.InitiallyUnowned
+}
+
+func marshalContextMenuItem(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapContextMenuItem(obj), nil
+}
+
+func wrapContextMenuItem(obj *glib.Object) *ContextMenuItem {
+	return &ContextMenuItem{glib.InitiallyUnowned{Object: obj}}
+}
+
+// native returns a pointer to the underlying WebKitContextMenuItem.
+func (item *ContextMenuItem) native() *C.WebKitContextMenuItem {
+	if item == nil || item.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(item.GObject)
+	return C.toWebKitContextMenuItem(p)
+}
+
+// NewContextMenuItemFromStockAction is a wrapper around
+// webkit_context_menu_item_new_from_stock_action().
+func NewContextMenuItemFromStockAction(action ContextMenuAction) *ContextMenuItem {
+	c := C.webkit_context_menu_item_new_from_stock_action(C.WebKitContextMenuAction(action))
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapContextMenuItem(obj)
+}
+
+// NewContextMenuItemFromStockActionWithLabel is a wrapper around
+// webkit_context_menu_item_new_from_stock_action_with_label().
+func NewContextMenuItemFromStockActionWithLabel(action ContextMenuAction, label string) *ContextMenuItem {
+	cstr := C.CString(label)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.webkit_context_menu_item_new_from_stock_action_with_label(
+		C.WebKitContextMenuAction(action), (*C.gchar)(cstr))
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapContextMenuItem(obj)
+}
+
+// NewContextMenuItemWithSubmenu is a wrapper around
+// webkit_context_menu_item_new_with_submenu().
+func NewContextMenuItemWithSubmenu(label string, submenu *ContextMenu) *ContextMenuItem {
+	cstr := C.CString(label)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.webkit_context_menu_item_new_with_submenu((*C.gchar)(cstr),
+		submenu.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapContextMenuItem(obj)
+}
+
+// NewContextMenuItemSeparator is a wrapper around
+// webkit_context_menu_item_new_separator().
+func NewContextMenuItemSeparator() *ContextMenuItem {
+	c := C.webkit_context_menu_item_new_separator()
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapContextMenuItem(obj)
+}
+
+// customActions counts the GtkActions created for custom context menu
+// items, so each is given a unique name.
+var customActions uint32
+
+// NewCustomContextMenuItem creates a context menu item with the stock
+// action ContextMenuActionCustom, which calls f when activated.  It is a
+// wrapper around webkit_context_menu_item_new(), creating a GtkAction
+// with the label whose activate signal is connected to f.
+func NewCustomContextMenuItem(label string, f func()) *ContextMenuItem {
+	n := atomic.AddUint32(&customActions, 1)
+	cName := C.CString(fmt.Sprintf("wk2-custom-action-%d", n))
+	cLabel := C.CString(label)
+	defer C.free(unsafe.Pointer(cName))
+	defer C.free(unsafe.Pointer(cLabel))
+
+	action := C.gtk_action_new((*C.gchar)(cName), (*C.gchar)(cLabel), nil,
+		nil)
+	if action == nil {
+		return nil
+	}
+	defer C.g_object_unref(C.gpointer(unsafe.Pointer(action)))
+	actionObj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(action))}
+	if _, err := actionObj.Connect("activate", func() { f() }); err != nil {
+		return nil
+	}
+
+	c := C.webkit_context_menu_item_new(action)
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapContextMenuItem(obj)
+}
+
+// Label returns the label of the GtkAction of the item, as returned by
+// webkit_context_menu_item_get_action().
+func (item *ContextMenuItem) Label() string {
+	action := C.webkit_context_menu_item_get_action(item.native())
+	if action == nil {
+		return ""
+	}
+	c := C.gtk_action_get_label(action)
+	return C.GoString((*C.char)(c))
+}
+
+// StockAction is a wrapper around
+// webkit_context_menu_item_get_stock_action().
+func (item *ContextMenuItem) StockAction() ContextMenuAction {
+	c := C.webkit_context_menu_item_get_stock_action(item.native())
+	return ContextMenuAction(c)
+}
+
+// IsSeparator is a wrapper around webkit_context_menu_item_is_separator().
+func (item *ContextMenuItem) IsSeparator() bool {
+	c := C.webkit_context_menu_item_is_separator(item.native())
+	return gobool(c)
+}
+
+// Submenu is a wrapper around webkit_context_menu_item_get_submenu().
+func (item *ContextMenuItem) Submenu() *ContextMenu {
+	c := C.webkit_context_menu_item_get_submenu(item.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapContextMenu(obj)
+}
+
+// SetSubmenu is a wrapper around webkit_context_menu_item_set_submenu().
+func (item *ContextMenuItem) SetSubmenu(submenu *ContextMenu) {
+	C.webkit_context_menu_item_set_submenu(item.native(), submenu.native())
+}
+
+//
+// WebKitCookieManager
+//
+
+// CookieManager is a representation of WebKit2GTK+'s WebKitCookieManager.
+type CookieManager struct {
+	*glib.Object
+}
+
+func marshalCookieManager(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapCookieManager(obj), nil
+}
+
+func wrapCookieManager(obj *glib.Object) *CookieManager {
+	return &CookieManager{obj}
+}
+
+// native returns a pointer to the underlying WebKitCookieManager.
+func (m *CookieManager) native() *C.WebKitCookieManager {
+	if m == nil || m.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(m.GObject)
+	return C.toWebKitCookieManager(p)
+}
+
+//
+// WebKitCredential
+//
+
+// Credential is a representation of WebKit2GTK+'s WebKitCredential.
+type Credential struct {
+	credential *C.WebKitCredential
+}
+
+func marshalCredential(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	// The boxed value is owned by the GValue, so a copy is wrapped
+	// instead.
+	credential := C.webkit_credential_copy((*C.WebKitCredential)(unsafe.Pointer(c)))
+	wrapped := wrapCredential(credential)
+	runtime.SetFinalizer(wrapped, (*Credential).free)
+	return wrapped, nil
+}
+
+func wrapCredential(credential *C.WebKitCredential) *Credential {
+	return &Credential{credential}
+}
+
+// native returns a pointer to the underlying WebKitCredential.
+func (c *Credential) native() *C.WebKitCredential {
+	if c == nil {
+		return nil
+	}
+	return c.credential
+}
+
+// Native returns a pointer to the underlying WebKitCredential.
+func (c *Credential) Native() uintptr {
+	return uintptr(unsafe.Pointer(c.native()))
+}
+
+// free is a wrapper around webkit_credential_free().
+func (c *Credential) free() {
+	C.webkit_credential_free(c.native())
+}
+
+// NewCredential is a wrapper around webkit_credential_new().
+func NewCredential(username, password string, persistence CredentialPersistence) *Credential {
+	cUsername := C.CString(username)
+	cPassword := C.CString(password)
+	defer C.free(unsafe.Pointer(cUsername))
+	defer C.free(unsafe.Pointer(cPassword))
+	c := C.webkit_credential_new((*C.gchar)(cUsername),
+		(*C.gchar)(cPassword), C.WebKitCredentialPersistence(persistence))
+	if c == nil {
+		return nil
+	}
+	wrapped := wrapCredential(c)
+	runtime.SetFinalizer(wrapped, (*Credential).free)
+	return wrapped
+}
+
+// Copy is a wrapper around webkit_credential_copy().
+func (c *Credential) Copy() *Credential {
+	credential := C.webkit_credential_copy(c.native())
+	if credential == nil {
+		return nil
+	}
+	wrapped := wrapCredential(credential)
+	runtime.SetFinalizer(wrapped, (*Credential).free)
+	return wrapped
+}
+
+// Username is a wrapper around webkit_credential_get_username().
+func (c *Credential) Username() string {
+	cstr := C.webkit_credential_get_username(c.native())
+	return C.GoString((*C.char)(cstr))
+}
+
+// Password is a wrapper around webkit_credential_get_password().
+func (c *Credential) Password() string {
+	cstr := C.webkit_credential_get_password(c.native())
+	return C.GoString((*C.char)(cstr))
+}
+
+// HasPassword is a wrapper around webkit_credential_has_password().
+func (c *Credential) HasPassword() bool {
+	b := C.webkit_credential_has_password(c.native())
+	return gobool(b)
+}
+
+// Persistence is a wrapper around webkit_credential_get_persistence().
+func (c *Credential) Persistence() CredentialPersistence {
+	p := C.webkit_credential_get_persistence(c.native())
+	return CredentialPersistence(p)
+}
+
+//
+// WebKitDownload
+//
+
+// Download is a representation of WebKit2GTK+'s WebKitDownload.
+type Download struct {
+	*glib.Object
+}
+
+func marshalDownload(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapDownload(obj), nil
+}
+
+func wrapDownload(obj *glib.Object) *Download {
+	return &Download{obj}
+}
+
+// native returns a pointer to the underlying WebKitDownload.
+func (d *Download) native() *C.WebKitDownload {
+	if d == nil || d.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(d.GObject)
+	return C.toWebKitDownload(p)
+}
+
+//
+// WebKitFaviconDatabase
+//
+
+// FaviconDatabase is a representation of WebKit2GTK+'s WebKitFaviconDatabase.
+type FaviconDatabase struct {
+	*glib.Object
+}
+
+func marshalFaviconDatabase(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
 	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
 	return wrapFaviconDatabase(obj), nil
 }
 
-func wrapFaviconDatabase(obj *glib.Object) *FaviconDatabase {
-	return &FaviconDatabase{Object: obj}
+func wrapFaviconDatabase(obj *glib.Object) *FaviconDatabase {
+	return &FaviconDatabase{Object: obj}
+}
+
+// native returns a pointer to the underlying WebKitFaviconDatabase.
+func (d *FaviconDatabase) native() *C.WebKitFaviconDatabase {
+	if d == nil || d.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(d.GObject)
+	return C.toWebKitFaviconDatabase(p)
+}
+
+// Favicon is a wrapper around webkit_favicon_database_get_favicon() and
+// webkit_favicon_database_get_favicon_finish().  The favicon is returned
+// as an *image.RGBA.
+func (d *FaviconDatabase) Favicon(ctx context.Context, pageURI string) (image.Image, error) {
+	var img *image.RGBA
+	err := runAsync(ctx, func(cancellable *C.GCancellable,
+		callback C.GAsyncReadyCallback, data C.gpointer) {
+
+		cstr := C.CString(pageURI)
+		defer C.free(unsafe.Pointer(cstr))
+		C.webkit_favicon_database_get_favicon(d.native(), (*C.gchar)(cstr),
+			cancellable, callback, data)
+	}, func(res *C.GAsyncResult) error {
+		var gerr *C.GError
+		surface := C.webkit_favicon_database_get_favicon_finish(d.native(),
+			res, &gerr)
+		if surface == nil {
+			return goError(gerr)
+		}
+		defer C.cairo_surface_destroy(surface)
+
+		var err error
+		img, err = imageSurfaceRGBA(surface)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// FaviconURI is a wrapper around webkit_favicon_database_get_favicon_uri().
+func (d *FaviconDatabase) FaviconURI(pageURI string) string {
+	cstr := C.CString(pageURI)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.webkit_favicon_database_get_favicon_uri(d.native(), (*C.gchar)(cstr))
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(unsafe.Pointer(c)))
+	return C.GoString((*C.char)(c))
+}
+
+// Clear is a wrapper around webkit_favicon_database_clear().
+func (d *FaviconDatabase) Clear() {
+	C.webkit_favicon_database_clear(d.native())
+}
+
+// OnFaviconChanged connects f to the FaviconDatabase's favicon-changed
+// signal.  f is called with the URI of a page whose favicon changed and
+// the URI of the new favicon.
+func (d *FaviconDatabase) OnFaviconChanged(f func(pageURI, faviconURI string)) (glib.SignalHandle, error) {
+	return d.Connect("favicon-changed", func(_ *FaviconDatabase,
+		pageURI, faviconURI string) {
+
+		f(pageURI, faviconURI)
+	})
+}
+
+//
+// WebKitFileChooserRequest
+//
+
+// FileChooserRequest is a representation of WebKit2GTK+'s
+// WebKitFileChooserRequest.
+type FileChooserRequest struct {
+	*glib.Object
+}
+
+func marshalFileChooserRequest(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapFileChooserRequest(obj), nil
+}
+
+func wrapFileChooserRequest(obj *glib.Object) *FileChooserRequest {
+	return &FileChooserRequest{obj}
+}
+
+// native returns a pointer to the underlying WebKitFileChooserRequest.
+func (r *FileChooserRequest) native() *C.WebKitFileChooserRequest {
+	if r == nil || r.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(r.GObject)
+	return C.toWebKitFileChooserRequest(p)
+}
+
+// MIMETypes is a wrapper around
+// webkit_file_chooser_request_get_mime_types().
+func (r *FileChooserRequest) MIMETypes() []string {
+	c := C.webkit_file_chooser_request_get_mime_types(r.native())
+	if c == nil {
+		return nil
+	}
+	v := (**C.gchar)(unsafe.Pointer(c))
+
+	var mimeTypes []string
+	for i := 0; C.peekGCharArray(v, C.int(i)) != nil; i++ {
+		cstr := C.peekGCharArray(v, C.int(i))
+		mimeTypes = append(mimeTypes, C.GoString((*C.char)(cstr)))
+	}
+	return mimeTypes
+}
+
+// MIMETypesFilter is a wrapper around
+// webkit_file_chooser_request_get_mime_types_filter().  The returned
+// object is the request's GtkFileFilter, or nil if the request does not
+// filter files by MIME type.
+func (r *FileChooserRequest) MIMETypesFilter() *glib.Object {
+	c := C.webkit_file_chooser_request_get_mime_types_filter(r.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return obj
+}
+
+// SelectMultiple is a wrapper around
+// webkit_file_chooser_request_get_select_multiple().
+func (r *FileChooserRequest) SelectMultiple() bool {
+	c := C.webkit_file_chooser_request_get_select_multiple(r.native())
+	return gobool(c)
+}
+
+// SelectFiles is a wrapper around
+// webkit_file_chooser_request_select_files().
+func (r *FileChooserRequest) SelectFiles(files []string) {
+	// One more element than files is allocated for the terminating
+	// NULL.
+	cFiles := C.allocGCharArray((C.size_t)(len(files) + 1))
+	for i, s := range files {
+		cstr := C.CString(s)
+		C.pokeGCharArray(cFiles, C.int(i), (*C.gchar)(cstr))
+	}
+	defer C.freeGCharArray(cFiles)
+
+	C.webkit_file_chooser_request_select_files(r.native(), cFiles)
+}
+
+// SelectedFiles is a wrapper around
+// webkit_file_chooser_request_get_selected_files().
+func (r *FileChooserRequest) SelectedFiles() []string {
+	c := C.webkit_file_chooser_request_get_selected_files(r.native())
+	if c == nil {
+		return nil
+	}
+	v := (**C.gchar)(unsafe.Pointer(c))
+
+	var files []string
+	for i := 0; C.peekGCharArray(v, C.int(i)) != nil; i++ {
+		cstr := C.peekGCharArray(v, C.int(i))
+		files = append(files, C.GoString((*C.char)(cstr)))
+	}
+	return files
+}
+
+// Cancel is a wrapper around webkit_file_chooser_request_cancel().
+func (r *FileChooserRequest) Cancel() {
+	C.webkit_file_chooser_request_cancel(r.native())
+}
+
+//
+// WebKitFindController
+//
+
+// FindController is a representation of WebKit2GTK+'s WebKitFindController.
+type FindController struct {
+	*glib.Object
+}
+
+func marshalFindController(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapFindController(obj), nil
+}
+
+func wrapFindController(obj *glib.Object) *FindController {
+	return &FindController{obj}
+}
+
+// native returns a pointer to the underlying WebKitFindController.
+func (f *FindController) native() *C.WebKitFindController {
+	if f == nil || f.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(f.GObject)
+	return C.toWebKitFindController(p)
+}
+
+// Search is a wrapper around webkit_find_controller_search().
+func (f *FindController) Search(text string, options FindOptions, maxMatchCount uint) {
+	cstr := C.CString(text)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_find_controller_search(f.native(), (*C.gchar)(cstr),
+		C.guint32(options), C.guint(maxMatchCount))
+}
+
+// SearchNext is a wrapper around webkit_find_controller_search_next().
+func (f *FindController) SearchNext() {
+	C.webkit_find_controller_search_next(f.native())
+}
+
+// SearchPrevious is a wrapper around
+// webkit_find_controller_search_previous().
+func (f *FindController) SearchPrevious() {
+	C.webkit_find_controller_search_previous(f.native())
+}
+
+// SearchFinish is a wrapper around webkit_find_controller_search_finish().
+func (f *FindController) SearchFinish() {
+	C.webkit_find_controller_search_finish(f.native())
+}
+
+// CountMatches is a wrapper around webkit_find_controller_count_matches().
+// The number of matches is delivered to the counted-matches signal.
+func (f *FindController) CountMatches(text string, options FindOptions, maxMatchCount uint) {
+	cstr := C.CString(text)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_find_controller_count_matches(f.native(), (*C.gchar)(cstr),
+		C.guint32(options), C.guint(maxMatchCount))
+}
+
+// SearchText is a wrapper around webkit_find_controller_get_search_text().
+func (f *FindController) SearchText() string {
+	c := C.webkit_find_controller_get_search_text(f.native())
+	return C.GoString((*C.char)(c))
+}
+
+// Options is a wrapper around webkit_find_controller_get_options().
+func (f *FindController) Options() FindOptions {
+	c := C.webkit_find_controller_get_options(f.native())
+	return FindOptions(c)
+}
+
+// MaxMatchCount is a wrapper around
+// webkit_find_controller_get_max_match_count().
+func (f *FindController) MaxMatchCount() uint {
+	c := C.webkit_find_controller_get_max_match_count(f.native())
+	return uint(c)
+}
+
+// WebView is a wrapper around webkit_find_controller_get_web_view().
+func (f *FindController) WebView() *WebView {
+	c := C.webkit_find_controller_get_web_view(f.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapWebView(obj)
+}
+
+// OnFoundText connects fn to the FindController's found-text signal.  fn
+// is called with the number of matches when a search finds the text.
+func (f *FindController) OnFoundText(fn func(matchCount uint)) (glib.SignalHandle, error) {
+	return f.Connect("found-text", func(_ *FindController, n uint) {
+		fn(n)
+	})
+}
+
+// OnFailedToFindText connects fn to the FindController's
+// failed-to-find-text signal.  fn is called when a search does not find
+// the text.
+func (f *FindController) OnFailedToFindText(fn func()) (glib.SignalHandle, error) {
+	return f.Connect("failed-to-find-text", func(_ *FindController) {
+		fn()
+	})
+}
+
+// OnCountedMatches connects fn to the FindController's counted-matches
+// signal.  fn is called with the number of matches counted by
+// CountMatches.
+func (f *FindController) OnCountedMatches(fn func(matchCount uint)) (glib.SignalHandle, error) {
+	return f.Connect("counted-matches", func(_ *FindController, n uint) {
+		fn(n)
+	})
+}
+
+//
+// WebKitGeolocationPermissionRequest
+//
+
+// GeolocationPermissionRequest is a representation of WebKit2GTK+'s
+// WebKitGeolocationPermissionRequest.
+type GeolocationPermissionRequest struct {
+	*glib.Object
+}
+
+func marshalGeolocationPermissionRequest(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapGeolocationPermissionRequest(obj), nil
+}
+
+func wrapGeolocationPermissionRequest(obj *glib.Object) *GeolocationPermissionRequest {
+	return &GeolocationPermissionRequest{obj}
+}
+
+// native returns a pointer to the underlying
+// WebKitGeolocationPermissionRequest.
+func (r *GeolocationPermissionRequest) native() *C.WebKitGeolocationPermissionRequest {
+	if r == nil || r.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(r.GObject)
+	return C.toWebKitGeolocationPermissionRequest(p)
+}
+
+// Allow is a wrapper around webkit_permission_request_allow().
+func (r *GeolocationPermissionRequest) Allow() {
+	permissionRequestAllow(r.Object)
+}
+
+// Deny is a wrapper around webkit_permission_request_deny().
+func (r *GeolocationPermissionRequest) Deny() {
+	permissionRequestDeny(r.Object)
+}
+
+// Kind returns PermissionGeolocation.
+func (r *GeolocationPermissionRequest) Kind() PermissionKind {
+	return PermissionGeolocation
+}
+
+//
+// WebKitHitTestResult
+//
+
+// HitTestResult is a representation of WebKit2GTK+'s WebKitHitTestResult.
+type HitTestResult struct {
+	*glib.Object
+}
+
+func marshalHitTestResult(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapHitTestResult(obj), nil
+}
+
+func wrapHitTestResult(obj *glib.Object) *HitTestResult {
+	return &HitTestResult{obj}
+}
+
+// native returns a pointer to the underlying WebKitHitTestResult.
+func (r *HitTestResult) native() *C.WebKitHitTestResult {
+	if r == nil || r.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(r.GObject)
+	return C.toWebKitHitTestResult(p)
+}
+
+// Context is a wrapper around webkit_hit_test_result_get_context().
+func (r *HitTestResult) Context() HitTestResultContext {
+	c := C.webkit_hit_test_result_get_context(r.native())
+	return HitTestResultContext(c)
+}
+
+// ContextIsLink is a wrapper around
+// webkit_hit_test_result_context_is_link().
+func (r *HitTestResult) ContextIsLink() bool {
+	c := C.webkit_hit_test_result_context_is_link(r.native())
+	return gobool(c)
+}
+
+// ContextIsImage is a wrapper around
+// webkit_hit_test_result_context_is_image().
+func (r *HitTestResult) ContextIsImage() bool {
+	c := C.webkit_hit_test_result_context_is_image(r.native())
+	return gobool(c)
+}
+
+// ContextIsMedia is a wrapper around
+// webkit_hit_test_result_context_is_media().
+func (r *HitTestResult) ContextIsMedia() bool {
+	c := C.webkit_hit_test_result_context_is_media(r.native())
+	return gobool(c)
+}
+
+// ContextIsEditable is a wrapper around
+// webkit_hit_test_result_context_is_editable().
+func (r *HitTestResult) ContextIsEditable() bool {
+	c := C.webkit_hit_test_result_context_is_editable(r.native())
+	return gobool(c)
+}
+
+// ContextIsScrollbar returns whether the hit test result's context
+// includes HitTestResultContextScrollbar.
+func (r *HitTestResult) ContextIsScrollbar() bool {
+	return r.Context()&HitTestResultContextScrollbar != 0
+}
+
+// LinkURI is a wrapper around webkit_hit_test_result_get_link_uri().
+func (r *HitTestResult) LinkURI() string {
+	c := C.webkit_hit_test_result_get_link_uri(r.native())
+	return C.GoString((*C.char)(c))
+}
+
+// LinkTitle is a wrapper around webkit_hit_test_result_get_link_title().
+func (r *HitTestResult) LinkTitle() string {
+	c := C.webkit_hit_test_result_get_link_title(r.native())
+	return C.GoString((*C.char)(c))
+}
+
+// LinkLabel is a wrapper around webkit_hit_test_result_get_link_label().
+func (r *HitTestResult) LinkLabel() string {
+	c := C.webkit_hit_test_result_get_link_label(r.native())
+	return C.GoString((*C.char)(c))
+}
+
+// ImageURI is a wrapper around webkit_hit_test_result_get_image_uri().
+func (r *HitTestResult) ImageURI() string {
+	c := C.webkit_hit_test_result_get_image_uri(r.native())
+	return C.GoString((*C.char)(c))
+}
+
+// MediaURI is a wrapper around webkit_hit_test_result_get_media_uri().
+func (r *HitTestResult) MediaURI() string {
+	c := C.webkit_hit_test_result_get_media_uri(r.native())
+	return C.GoString((*C.char)(c))
+}
+
+//
+// WebKitNavigationPolicyDecision
+//
+
+// NavigationPolicyDecision is a representation of WebKit2GTK+'s
+// WebKitNavigationPolicyDecision.
+type NavigationPolicyDecision struct {
+	PolicyDecision
+}
+
+func marshalNavigationPolicyDecision(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapNavigationPolicyDecision(obj), nil
+}
+
+func wrapNavigationPolicyDecision(obj *glib.Object) *NavigationPolicyDecision {
+	return &NavigationPolicyDecision{PolicyDecision{obj}}
+}
+
+// native returns a pointer to the underlying
+// WebKitNavigationPolicyDecision.
+func (d *NavigationPolicyDecision) native() *C.WebKitNavigationPolicyDecision {
+	if d == nil || d.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(d.GObject)
+	return C.toWebKitNavigationPolicyDecision(p)
+}
+
+// NavigationType is a wrapper around
+// webkit_navigation_policy_decision_get_navigation_type().
+func (d *NavigationPolicyDecision) NavigationType() NavigationType {
+	c := C.webkit_navigation_policy_decision_get_navigation_type(d.native())
+	return NavigationType(c)
+}
+
+// MouseButton is a wrapper around
+// webkit_navigation_policy_decision_get_mouse_button().
+func (d *NavigationPolicyDecision) MouseButton() uint {
+	c := C.webkit_navigation_policy_decision_get_mouse_button(d.native())
+	return uint(c)
+}
+
+// Modifiers is a wrapper around
+// webkit_navigation_policy_decision_get_modifiers().
+func (d *NavigationPolicyDecision) Modifiers() uint {
+	c := C.webkit_navigation_policy_decision_get_modifiers(d.native())
+	return uint(c)
+}
+
+// Request is a wrapper around
+// webkit_navigation_policy_decision_get_request().
+func (d *NavigationPolicyDecision) Request() *URIRequest {
+	c := C.webkit_navigation_policy_decision_get_request(d.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapURIRequest(obj)
+}
+
+// FrameName is a wrapper around
+// webkit_navigation_policy_decision_get_frame_name().
+func (d *NavigationPolicyDecision) FrameName() string {
+	c := C.webkit_navigation_policy_decision_get_frame_name(d.native())
+	return C.GoString((*C.char)(c))
+}
+
+//
+// WebKitPermissionRequest
+//
+
+// PermissionKind describes the permission requested by a
+// PermissionRequest.
+type PermissionKind string
+
+// These constants define the kinds of PermissionRequest.  Requests of a
+// kind unknown to this package have the kind PermissionUnknown.
+//
+// WebKit2GTK+ 2.4 only requests geolocation permission.  Notification
+// permission requests (WebKitNotificationPermissionRequest) were added in
+// WebKit2GTK+ 2.8, so there is no NotificationPermissionRequest or
+// notification PermissionKind.
+const (
+	PermissionUnknown     PermissionKind = ""
+	PermissionGeolocation PermissionKind = "geolocation"
+)
+
+// PermissionRequest is a representation of WebKit2GTK+'s
+// WebKitPermissionRequest interface.
+type PermissionRequest interface {
+	Allow()
+	Deny()
+	Kind() PermissionKind
+}
+
+// unknownPermissionRequest is a PermissionRequest of a type without a Go
+// representation.
+type unknownPermissionRequest struct {
+	*glib.Object
+}
+
+func (r *unknownPermissionRequest) Allow() {
+	permissionRequestAllow(r.Object)
+}
+
+func (r *unknownPermissionRequest) Deny() {
+	permissionRequestDeny(r.Object)
+}
+
+func (r *unknownPermissionRequest) Kind() PermissionKind {
+	return PermissionUnknown
+}
+
+func marshalPermissionRequest(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapPermissionRequest(obj), nil
+}
+
+// wrapPermissionRequest wraps obj as the PermissionRequest implementation
+// of its instance type.
+func wrapPermissionRequest(obj *glib.Object) PermissionRequest {
+	p := unsafe.Pointer(obj.GObject)
+	if gobool(C.isWebKitGeolocationPermissionRequest(p)) {
+		return wrapGeolocationPermissionRequest(obj)
+	}
+	return &unknownPermissionRequest{obj}
+}
+
+// permissionRequestObject returns the object wrapped by a
+// PermissionRequest implementation of this package, or nil for other
+// implementations.
+func permissionRequestObject(r PermissionRequest) *glib.Object {
+	switch r := r.(type) {
+	case *GeolocationPermissionRequest:
+		return r.Object
+	case *unknownPermissionRequest:
+		return r.Object
+	}
+	return nil
+}
+
+// permissionRequestAllow is a wrapper around
+// webkit_permission_request_allow().
+func permissionRequestAllow(obj *glib.Object) {
+	p := unsafe.Pointer(obj.GObject)
+	C.webkit_permission_request_allow(C.toWebKitPermissionRequest(p))
+}
+
+// permissionRequestDeny is a wrapper around
+// webkit_permission_request_deny().
+func permissionRequestDeny(obj *glib.Object) {
+	p := unsafe.Pointer(obj.GObject)
+	C.webkit_permission_request_deny(C.toWebKitPermissionRequest(p))
+}
+
+// PermissionPolicy is the interface implemented by types which decide
+// the permission requests of a WebView.  DecidePermission is called with
+// the origin of the WebView's current page and the request, and returns
+// true if the request was handled, either by calling its Allow or Deny
+// method or by keeping the request to be decided later.  If false is
+// returned, WebKit's default handling of the request is used.
+type PermissionPolicy interface {
+	DecidePermission(origin string, r PermissionRequest) bool
+}
+
+//
+// WebKitPolicyDecision
+//
+
+// PolicyDecision is a representation of WebKit2GTK+'s WebKitPolicyDecision.
+type PolicyDecision struct {
+	*glib.Object
+}
+
+func marshalPolicyDecision(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapPolicyDecision(obj), nil
+}
+
+func wrapPolicyDecision(obj *glib.Object) *PolicyDecision {
+	return &PolicyDecision{obj}
+}
+
+// native returns a pointer to the underlying WebKitPolicyDecision.
+func (d *PolicyDecision) native() *C.WebKitPolicyDecision {
+	if d == nil || d.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(d.GObject)
+	return C.toWebKitPolicyDecision(p)
+}
+
+// Use is a wrapper around webkit_policy_decision_use().
+func (d *PolicyDecision) Use() {
+	C.webkit_policy_decision_use(d.native())
+}
+
+// Ignore is a wrapper around webkit_policy_decision_ignore().
+func (d *PolicyDecision) Ignore() {
+	C.webkit_policy_decision_ignore(d.native())
+}
+
+// Download is a wrapper around webkit_policy_decision_download().
+func (d *PolicyDecision) Download() {
+	C.webkit_policy_decision_download(d.native())
+}
+
+// PolicyDecider is the interface implemented by types which make the
+// policy decisions of a WebView.  Each method returns true if the
+// decision was handled, either by calling one of the decision's Use,
+// Ignore or Download methods or by keeping the decision to be made
+// later.  If false is returned, WebKit's default policy is applied.
+type PolicyDecider interface {
+	// DecideNavigationPolicy decides the policy of a navigation action
+	// or a new window action, as described by t.
+	DecideNavigationPolicy(d *NavigationPolicyDecision, t PolicyDecisionType) bool
+
+	// DecideResponsePolicy decides the policy of a resource response.
+	DecideResponsePolicy(d *ResponsePolicyDecision) bool
+}
+
+//
+// WebKitResponsePolicyDecision
+//
+
+// ResponsePolicyDecision is a representation of WebKit2GTK+'s
+// WebKitResponsePolicyDecision.
+type ResponsePolicyDecision struct {
+	PolicyDecision
+}
+
+func marshalResponsePolicyDecision(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapResponsePolicyDecision(obj), nil
+}
+
+func wrapResponsePolicyDecision(obj *glib.Object) *ResponsePolicyDecision {
+	return &ResponsePolicyDecision{PolicyDecision{obj}}
+}
+
+// native returns a pointer to the underlying WebKitResponsePolicyDecision.
+func (d *ResponsePolicyDecision) native() *C.WebKitResponsePolicyDecision {
+	if d == nil || d.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(d.GObject)
+	return C.toWebKitResponsePolicyDecision(p)
+}
+
+// Request is a wrapper around webkit_response_policy_decision_get_request().
+func (d *ResponsePolicyDecision) Request() *URIRequest {
+	c := C.webkit_response_policy_decision_get_request(d.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapURIRequest(obj)
+}
+
+// Response is a wrapper around webkit_response_policy_decision_get_response().
+func (d *ResponsePolicyDecision) Response() *URIResponse {
+	c := C.webkit_response_policy_decision_get_response(d.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapURIResponse(obj)
+}
+
+// IsMIMETypeSupported is a wrapper around
+// webkit_response_policy_decision_is_mime_type_supported().
+func (d *ResponsePolicyDecision) IsMIMETypeSupported() bool {
+	c := C.webkit_response_policy_decision_is_mime_type_supported(d.native())
+	return gobool(c)
+}
+
+//
+// WebKitScriptDialog
+//
+
+// ScriptDialog is a representation of WebKit2GTK+'s WebKitScriptDialog.
+// A ScriptDialog is owned by the script-dialog signal which delivers it,
+// and must not be used after the signal handler returns.
+type ScriptDialog struct {
+	dialog *C.WebKitScriptDialog
+}
+
+func marshalScriptDialog(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	dialog := (*C.WebKitScriptDialog)(unsafe.Pointer(c))
+	return wrapScriptDialog(dialog), nil
+}
+
+func wrapScriptDialog(dialog *C.WebKitScriptDialog) *ScriptDialog {
+	return &ScriptDialog{dialog}
+}
+
+// native returns a pointer to the underlying WebKitScriptDialog.
+func (d *ScriptDialog) native() *C.WebKitScriptDialog {
+	if d == nil {
+		return nil
+	}
+	return d.dialog
+}
+
+// Native returns a pointer to the underlying WebKitScriptDialog.
+func (d *ScriptDialog) Native() uintptr {
+	return uintptr(unsafe.Pointer(d.native()))
+}
+
+// DialogType is a wrapper around webkit_script_dialog_get_dialog_type().
+func (d *ScriptDialog) DialogType() ScriptDialogType {
+	c := C.webkit_script_dialog_get_dialog_type(d.native())
+	return ScriptDialogType(c)
+}
+
+// Message is a wrapper around webkit_script_dialog_get_message().
+func (d *ScriptDialog) Message() string {
+	c := C.webkit_script_dialog_get_message(d.native())
+	return C.GoString((*C.char)(c))
+}
+
+// ConfirmSetConfirmed is a wrapper around
+// webkit_script_dialog_confirm_set_confirmed().
+func (d *ScriptDialog) ConfirmSetConfirmed(confirmed bool) {
+	C.webkit_script_dialog_confirm_set_confirmed(d.native(), gbool(confirmed))
+}
+
+// PromptDefaultText is a wrapper around
+// webkit_script_dialog_prompt_get_default_text().
+func (d *ScriptDialog) PromptDefaultText() string {
+	c := C.webkit_script_dialog_prompt_get_default_text(d.native())
+	return C.GoString((*C.char)(c))
+}
+
+// PromptSetText is a wrapper around webkit_script_dialog_prompt_set_text().
+func (d *ScriptDialog) PromptSetText(text string) {
+	cstr := C.CString(text)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_script_dialog_prompt_set_text(d.native(), (*C.gchar)(cstr))
+}
+
+//
+// WebKitSecurityManager
+//
+
+// SecurityManager is a representation of WebKit2GTK+'s WebKitSecurityManager.
+type SecurityManager struct {
+	*glib.Object
+}
+
+func marshalSecurityManager(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapSecurityManager(obj), nil
+}
+
+func wrapSecurityManager(obj *glib.Object) *SecurityManager {
+	return &SecurityManager{obj}
+}
+
+// native returns a pointer to the underlying WebKitSecurityManager.
+func (s *SecurityManager) native() *C.WebKitSecurityManager {
+	if s == nil || s.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(s.GObject)
+	return C.toWebKitSecurityManager(p)
+}
+
+//
+// WebKitSettings
+//
+
+// Settings is a representation of WebKit2GTK+'s WebKitSettings.
+type Settings struct {
+	*glib.Object
+}
+
+func marshalSettings(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapSettings(obj), nil
+}
+
+func wrapSettings(obj *glib.Object) *Settings {
+	return &Settings{obj}
+}
+
+// native returns a pointer to the underlying WebKitSettings.
+func (s *Settings) native() *C.WebKitSettings {
+	if s == nil || s.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(s.GObject)
+	return C.toWebKitSettings(p)
+}
+
+// NewSettings is a wrapper around webkit_settings_new().
+func NewSettings() *Settings {
+	c := C.webkit_settings_new()
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapSettings(obj)
+}
+
+// EnableJavaScript is a wrapper around webkit_settings_get_enable_javascript().
+func (s *Settings) EnableJavaScript() bool {
+	c := C.webkit_settings_get_enable_javascript(s.native())
+	return gobool(c)
+}
+
+// SetEnableJavaScript is a wrapper around
+// webkit_settings_set_enable_javascript().
+func (s *Settings) SetEnableJavaScript(enabled bool) {
+	C.webkit_settings_set_enable_javascript(s.native(), gbool(enabled))
+}
+
+// AutoLoadImages is a wrapper around webkit_settings_get_auto_load_images().
+func (s *Settings) AutoLoadImages() bool {
+	c := C.webkit_settings_get_auto_load_images(s.native())
+	return gobool(c)
+}
+
+// SetAutoLoadImages is a wrapper around webkit_settings_set_auto_load_images().
+func (s *Settings) SetAutoLoadImages(enabled bool) {
+	C.webkit_settings_set_auto_load_images(s.native(), gbool(enabled))
+}
+
+// LoadIconsIgnoringImageLoadSetting is a wrapper around
+// webkit_settings_get_load_icons_ignoring_image_load_setting().
+func (s *Settings) LoadIconsIgnoringImageLoadSetting() bool {
+	c := C.webkit_settings_get_load_icons_ignoring_image_load_setting(s.native())
+	return gobool(c)
+}
+
+// SetLoadIconsIgnoringImageLoadSetting is a wrapper around
+// webkit_settings_set_load_icons_ignoring_image_load_setting().
+func (s *Settings) SetLoadIconsIgnoringImageLoadSetting(enabled bool) {
+	C.webkit_settings_set_load_icons_ignoring_image_load_setting(s.native(),
+		gbool(enabled))
+}
+
+// EnableOfflineWebApplicationCache is a wrapper around
+// webkit_settings_get_enable_offline_web_application_cache().
+func (s *Settings) EnableOfflineWebApplicationCache() bool {
+	c := C.webkit_settings_get_enable_offline_web_application_cache(s.native())
+	return gobool(c)
+}
+
+// SetEnableOfflineWebApplicationCache is a wrapper around
+// webkit_settings_set_enable_offline_web_application_cache().
+func (s *Settings) SetEnableOfflineWebApplicationCache(enabled bool) {
+	C.webkit_settings_set_enable_offline_web_application_cache(s.native(),
+		gbool(enabled))
+}
+
+// EnableHTML5LocalStorage is a wrapper around
+// webkit_settings_get_enable_html5_local_storage().
+func (s *Settings) EnableHTML5LocalStorage() bool {
+	c := C.webkit_settings_get_enable_html5_local_storage(s.native())
+	return gobool(c)
+}
+
+// SetEnableHTML5LocalStorage is a wrapper around
+// webkit_settings_set_enable_html5_local_storage().
+func (s *Settings) SetEnableHTML5LocalStorage(enabled bool) {
+	C.webkit_settings_set_enable_html5_local_storage(s.native(),
+		gbool(enabled))
+}
+
+// EnableHTML5Database is a wrapper around
+// webkit_settings_get_enable_html5_database().
+func (s *Settings) EnableHTML5Database() bool {
+	c := C.webkit_settings_get_enable_html5_database(s.native())
+	return gobool(c)
+}
+
+// SetEnableHTML5Database is a wrapper around
+// webkit_settings_set_enable_html5_database().
+func (s *Settings) SetEnableHTML5Database(enabled bool) {
+	C.webkit_settings_set_enable_html5_database(s.native(), gbool(enabled))
+}
+
+// EnableXSSAuditor is a wrapper around
+// webkit_settings_get_enable_xss_auditor().
+func (s *Settings) EnableXSSAuditor() bool {
+	c := C.webkit_settings_get_enable_xss_auditor(s.native())
+	return gobool(c)
+}
+
+// SetEnableXSSAuditor is a wrapper around
+// webkit_settings_set_enable_xss_auditor().
+func (s *Settings) SetEnableXSSAuditor(enabled bool) {
+	C.webkit_settings_set_enable_xss_auditor(s.native(), gbool(enabled))
+}
+
+// EnableFrameFlattening is a wrapper around
+// webkit_settings_get_enable_frame_flattening().
+func (s *Settings) EnableFrameFlattening() bool {
+	c := C.webkit_settings_get_enable_frame_flattening(s.native())
+	return gobool(c)
+}
+
+// SetEnableFrameFlattening is a wrapper around
+// webkit_settings_set_enable_frame_flattening().
+func (s *Settings) SetEnableFrameFlattening(enabled bool) {
+	C.webkit_settings_set_enable_frame_flattening(s.native(),
+		gbool(enabled))
+}
+
+// EnablePlugins is a wrapper around webkit_settings_get_enable_plugins().
+func (s *Settings) EnablePlugins() bool {
+	c := C.webkit_settings_get_enable_plugins(s.native())
+	return gobool(c)
+}
+
+// SetEnablePlugins is a wrapper around webkit_settings_set_enable_plugins().
+func (s *Settings) SetEnablePlugins(enabled bool) {
+	C.webkit_settings_set_enable_plugins(s.native(), gbool(enabled))
+}
+
+// EnableJava is a wrapper around webkit_settings_get_enable_java().
+func (s *Settings) EnableJava() bool {
+	c := C.webkit_settings_get_enable_java(s.native())
+	return gobool(c)
+}
+
+// SetEnableJava is a wrapper around webkit_settings_set_enable_java().
+func (s *Settings) SetEnableJava(enabled bool) {
+	C.webkit_settings_set_enable_java(s.native(), gbool(enabled))
+}
+
+// JavaScriptCanOpenWindowsAutomatically is a wrapper around
+// webkit_settings_get_javascript_can_open_windows_automatically().
+func (s *Settings) JavaScriptCanOpenWindowsAutomatically() bool {
+	c := C.webkit_settings_get_javascript_can_open_windows_automatically(s.native())
+	return gobool(c)
+}
+
+// SetJavaScriptCanOpenWindowsAutomatically is a wrapper around
+// webkit_settings_set_javascript_can_open_windows_automatically().
+func (s *Settings) SetJavaScriptCanOpenWindowsAutomatically(enabled bool) {
+	C.webkit_settings_set_javascript_can_open_windows_automatically(s.native(),
+		gbool(enabled))
+}
+
+// EnableHyperlinkAuditing is a wrapper around
+// webkit_settings_get_enable_hyperlink_auditing().
+func (s *Settings) EnableHyperlinkAuditing() bool {
+	c := C.webkit_settings_get_enable_hyperlink_auditing(s.native())
+	return gobool(c)
+}
+
+// SetEnableHyperlinkAuditing is a wrapper around
+// webkit_settings_set_enable_hyperlink_auditing().
+func (s *Settings) SetEnableHyperlinkAuditing(enabled bool) {
+	C.webkit_settings_set_enable_hyperlink_auditing(s.native(),
+		gbool(enabled))
+}
+
+// DefaultFontFamily is a wrapper around
+// webkit_settings_get_default_font_family().
+func (s *Settings) DefaultFontFamily() string {
+	c := C.webkit_settings_get_default_font_family(s.native())
+	return C.GoString((*C.char)(c))
+}
+
+// SetDefaultFontFamily is a wrapper around
+// webkit_settings_set_default_font_family().
+func (s *Settings) SetDefaultFontFamily(family string) {
+	cstr := C.CString(family)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_settings_set_default_font_family(s.native(), (*C.gchar)(cstr))
+}
+
+// MonospaceFontFamily is a wrapper around
+// webkit_settings_get_monospace_font_family().
+func (s *Settings) MonospaceFontFamily() string {
+	c := C.webkit_settings_get_monospace_font_family(s.native())
+	return C.GoString((*C.char)(c))
+}
+
+// SetMonospaceFontFamily is a wrapper around
+// webkit_settings_set_monospace_font_family().
+func (s *Settings) SetMonospaceFontFamily(family string) {
+	cstr := C.CString(family)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_settings_set_monospace_font_family(s.native(),
+		(*C.gchar)(cstr))
+}
+
+// SerifFontFamily is a wrapper around webkit_settings_get_serif_font_family().
+func (s *Settings) SerifFontFamily() string {
+	c := C.webkit_settings_get_serif_font_family(s.native())
+	return C.GoString((*C.char)(c))
+}
+
+// SetSerifFontFamily is a wrapper around
+// webkit_settings_set_serif_font_family().
+func (s *Settings) SetSerifFontFamily(family string) {
+	cstr := C.CString(family)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_settings_set_serif_font_family(s.native(), (*C.gchar)(cstr))
+}
+
+// SansSerifFontFamily is a wrapper around
+// webkit_settings_get_sans_serif_font_family().
+func (s *Settings) SansSerifFontFamily() string {
+	c := C.webkit_settings_get_sans_serif_font_family(s.native())
+	return C.GoString((*C.char)(c))
+}
+
+// SetSansSerifFontFamily is a wrapper around
+// webkit_settings_set_sans_serif_font_family().
+func (s *Settings) SetSansSerifFontFamily(family string) {
+	cstr := C.CString(family)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_settings_set_sans_serif_font_family(s.native(),
+		(*C.gchar)(cstr))
+}
+
+// CursiveFontFamily is a wrapper around
+// webkit_settings_get_cursive_font_family().
+func (s *Settings) CursiveFontFamily() string {
+	c := C.webkit_settings_get_cursive_font_family(s.native())
+	return C.GoString((*C.char)(c))
+}
+
+// SetCursiveFontFamily is a wrapper around
+// webkit_settings_set_cursive_font_family().
+func (s *Settings) SetCursiveFontFamily(family string) {
+	cstr := C.CString(family)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_settings_set_cursive_font_family(s.native(), (*C.gchar)(cstr))
+}
+
+// FantasyFontFamily is a wrapper around
+// webkit_settings_get_fantasy_font_family().
+func (s *Settings) FantasyFontFamily() string {
+	c := C.webkit_settings_get_fantasy_font_family(s.native())
+	return C.GoString((*C.char)(c))
+}
+
+// SetFantasyFontFamily is a wrapper around
+// webkit_settings_set_fantasy_font_family().
+func (s *Settings) SetFantasyFontFamily(family string) {
+	cstr := C.CString(family)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_settings_set_fantasy_font_family(s.native(), (*C.gchar)(cstr))
+}
+
+// PictographFontFamily is a wrapper around
+// webkit_settings_get_pictograph_font_family().
+func (s *Settings) PictographFontFamily() string {
+	c := C.webkit_settings_get_pictograph_font_family(s.native())
+	return C.GoString((*C.char)(c))
+}
+
+// SetPictographFontFamily is a wrapper around
+// webkit_settings_set_pictograph_font_family().
+func (s *Settings) SetPictographFontFamily(family string) {
+	cstr := C.CString(family)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_settings_set_pictograph_font_family(s.native(),
+		(*C.gchar)(cstr))
+}
+
+// DefaultFontSize is a wrapper around webkit_settings_get_default_font_size().
+func (s *Settings) DefaultFontSize() uint {
+	c := C.webkit_settings_get_default_font_size(s.native())
+	return uint(c)
+}
+
+// SetDefaultFontSize is a wrapper around
+// webkit_settings_set_default_font_size().
+func (s *Settings) SetDefaultFontSize(size uint) {
+	C.webkit_settings_set_default_font_size(s.native(), C.guint32(size))
+}
+
+// DefaultMonospaceFontSize is a wrapper around
+// webkit_settings_get_default_monospace_font_size().
+func (s *Settings) DefaultMonospaceFontSize() uint {
+	c := C.webkit_settings_get_default_monospace_font_size(s.native())
+	return uint(c)
+}
+
+// SetDefaultMonospaceFontSize is a wrapper around
+// webkit_settings_set_default_monospace_font_size().
+func (s *Settings) SetDefaultMonospaceFontSize(size uint) {
+	C.webkit_settings_set_default_monospace_font_size(s.native(),
+		C.guint32(size))
+}
+
+// MinimumFontSize is a wrapper around webkit_settings_get_minimum_font_size().
+func (s *Settings) MinimumFontSize() uint {
+	c := C.webkit_settings_get_minimum_font_size(s.native())
+	return uint(c)
+}
+
+// SetMinimumFontSize is a wrapper around
+// webkit_settings_set_minimum_font_size().
+func (s *Settings) SetMinimumFontSize(size uint) {
+	C.webkit_settings_set_minimum_font_size(s.native(), C.guint32(size))
+}
+
+// DefaultCharset is a wrapper around webkit_settings_get_default_charset().
+func (s *Settings) DefaultCharset() string {
+	c := C.webkit_settings_get_default_charset(s.native())
+	return C.GoString((*C.char)(c))
+}
+
+// SetDefaultCharset is a wrapper around webkit_settings_set_default_charset().
+func (s *Settings) SetDefaultCharset(charset string) {
+	cstr := C.CString(charset)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_settings_set_default_charset(s.native(), (*C.gchar)(cstr))
+}
+
+// EnablePrivateBrowsing is a wrapper around
+// webkit_settings_get_enable_private_browsing().
+func (s *Settings) EnablePrivateBrowsing() bool {
+	c := C.webkit_settings_get_enable_private_browsing(s.native())
+	return gobool(c)
+}
+
+// SetEnablePrivateBrowsing is a wrapper around
+// webkit_settings_set_enable_private_browsing().
+func (s *Settings) SetEnablePrivateBrowsing(enabled bool) {
+	C.webkit_settings_set_enable_private_browsing(s.native(),
+		gbool(enabled))
+}
+
+// EnableDeveloperExtras is a wrapper around
+// webkit_settings_get_enable_developer_extras().
+func (s *Settings) EnableDeveloperExtras() bool {
+	c := C.webkit_settings_get_enable_developer_extras(s.native())
+	return gobool(c)
+}
+
+// SetEnableDeveloperExtras is a wrapper around
+// webkit_settings_set_enable_developer_extras().
+func (s *Settings) SetEnableDeveloperExtras(enabled bool) {
+	C.webkit_settings_set_enable_developer_extras(s.native(),
+		gbool(enabled))
+}
+
+// EnableResizableTextAreas is a wrapper around
+// webkit_settings_get_enable_resizable_text_areas().
+func (s *Settings) EnableResizableTextAreas() bool {
+	c := C.webkit_settings_get_enable_resizable_text_areas(s.native())
+	return gobool(c)
+}
+
+// SetEnableResizableTextAreas is a wrapper around
+// webkit_settings_set_enable_resizable_text_areas().
+func (s *Settings) SetEnableResizableTextAreas(enabled bool) {
+	C.webkit_settings_set_enable_resizable_text_areas(s.native(),
+		gbool(enabled))
+}
+
+// EnableTabsToLinks is a wrapper around
+// webkit_settings_get_enable_tabs_to_links().
+func (s *Settings) EnableTabsToLinks() bool {
+	c := C.webkit_settings_get_enable_tabs_to_links(s.native())
+	return gobool(c)
+}
+
+// SetEnableTabsToLinks is a wrapper around
+// webkit_settings_set_enable_tabs_to_links().
+func (s *Settings) SetEnableTabsToLinks(enabled bool) {
+	C.webkit_settings_set_enable_tabs_to_links(s.native(), gbool(enabled))
+}
+
+// EnableDNSPrefetching is a wrapper around
+// webkit_settings_get_enable_dns_prefetching().
+func (s *Settings) EnableDNSPrefetching() bool {
+	c := C.webkit_settings_get_enable_dns_prefetching(s.native())
+	return gobool(c)
+}
+
+// SetEnableDNSPrefetching is a wrapper around
+// webkit_settings_set_enable_dns_prefetching().
+func (s *Settings) SetEnableDNSPrefetching(enabled bool) {
+	C.webkit_settings_set_enable_dns_prefetching(s.native(), gbool(enabled))
+}
+
+// EnableCaretBrowsing is a wrapper around
+// webkit_settings_get_enable_caret_browsing().
+func (s *Settings) EnableCaretBrowsing() bool {
+	c := C.webkit_settings_get_enable_caret_browsing(s.native())
+	return gobool(c)
+}
+
+// SetEnableCaretBrowsing is a wrapper around
+// webkit_settings_set_enable_caret_browsing().
+func (s *Settings) SetEnableCaretBrowsing(enabled bool) {
+	C.webkit_settings_set_enable_caret_browsing(s.native(), gbool(enabled))
+}
+
+// EnableFullscreen is a wrapper around webkit_settings_get_enable_fullscreen().
+func (s *Settings) EnableFullscreen() bool {
+	c := C.webkit_settings_get_enable_fullscreen(s.native())
+	return gobool(c)
+}
+
+// SetEnableFullscreen is a wrapper around
+// webkit_settings_set_enable_fullscreen().
+func (s *Settings) SetEnableFullscreen(enabled bool) {
+	C.webkit_settings_set_enable_fullscreen(s.native(), gbool(enabled))
+}
+
+// PrintBackgrounds is a wrapper around webkit_settings_get_print_backgrounds().
+func (s *Settings) PrintBackgrounds() bool {
+	c := C.webkit_settings_get_print_backgrounds(s.native())
+	return gobool(c)
+}
+
+// SetPrintBackgrounds is a wrapper around
+// webkit_settings_set_print_backgrounds().
+func (s *Settings) SetPrintBackgrounds(enabled bool) {
+	C.webkit_settings_set_print_backgrounds(s.native(), gbool(enabled))
+}
+
+// EnableWebAudio is a wrapper around webkit_settings_get_enable_webaudio().
+func (s *Settings) EnableWebAudio() bool {
+	c := C.webkit_settings_get_enable_webaudio(s.native())
+	return gobool(c)
+}
+
+// SetEnableWebAudio is a wrapper around webkit_settings_set_enable_webaudio().
+func (s *Settings) SetEnableWebAudio(enabled bool) {
+	C.webkit_settings_set_enable_webaudio(s.native(), gbool(enabled))
+}
+
+// EnableWebGL is a wrapper around webkit_settings_get_enable_webgl().
+func (s *Settings) EnableWebGL() bool {
+	c := C.webkit_settings_get_enable_webgl(s.native())
+	return gobool(c)
+}
+
+// SetEnableWebGL is a wrapper around webkit_settings_set_enable_webgl().
+func (s *Settings) SetEnableWebGL(enabled bool) {
+	C.webkit_settings_set_enable_webgl(s.native(), gbool(enabled))
+}
+
+// AllowModalDialogs is a wrapper around
+// webkit_settings_get_allow_modal_dialogs().
+func (s *Settings) AllowModalDialogs() bool {
+	c := C.webkit_settings_get_allow_modal_dialogs(s.native())
+	return gobool(c)
+}
+
+// SetAllowModalDialogs is a wrapper around
+// webkit_settings_set_allow_modal_dialogs().
+func (s *Settings) SetAllowModalDialogs(enabled bool) {
+	C.webkit_settings_set_allow_modal_dialogs(s.native(), gbool(enabled))
+}
+
+// ZoomTextOnly is a wrapper around webkit_settings_get_zoom_text_only().
+func (s *Settings) ZoomTextOnly() bool {
+	c := C.webkit_settings_get_zoom_text_only(s.native())
+	return gobool(c)
+}
+
+// SetZoomTextOnly is a wrapper around webkit_settings_set_zoom_text_only().
+func (s *Settings) SetZoomTextOnly(enabled bool) {
+	C.webkit_settings_set_zoom_text_only(s.native(), gbool(enabled))
+}
+
+// JavaScriptCanAccessClipboard is a wrapper around
+// webkit_settings_get_javascript_can_access_clipboard().
+func (s *Settings) JavaScriptCanAccessClipboard() bool {
+	c := C.webkit_settings_get_javascript_can_access_clipboard(s.native())
+	return gobool(c)
+}
+
+// SetJavaScriptCanAccessClipboard is a wrapper around
+// webkit_settings_set_javascript_can_access_clipboard().
+func (s *Settings) SetJavaScriptCanAccessClipboard(enabled bool) {
+	C.webkit_settings_set_javascript_can_access_clipboard(s.native(),
+		gbool(enabled))
+}
+
+// MediaPlaybackRequiresUserGesture is a wrapper around
+// webkit_settings_get_media_playback_requires_user_gesture().
+func (s *Settings) MediaPlaybackRequiresUserGesture() bool {
+	c := C.webkit_settings_get_media_playback_requires_user_gesture(s.native())
+	return gobool(c)
+}
+
+// SetMediaPlaybackRequiresUserGesture is a wrapper around
+// webkit_settings_set_media_playback_requires_user_gesture().
+func (s *Settings) SetMediaPlaybackRequiresUserGesture(enabled bool) {
+	C.webkit_settings_set_media_playback_requires_user_gesture(s.native(),
+		gbool(enabled))
+}
+
+// MediaPlaybackAllowsInline is a wrapper around
+// webkit_settings_get_media_playback_allows_inline().
+func (s *Settings) MediaPlaybackAllowsInline() bool {
+	c := C.webkit_settings_get_media_playback_allows_inline(s.native())
+	return gobool(c)
+}
+
+// SetMediaPlaybackAllowsInline is a wrapper around
+// webkit_settings_set_media_playback_allows_inline().
+func (s *Settings) SetMediaPlaybackAllowsInline(enabled bool) {
+	C.webkit_settings_set_media_playback_allows_inline(s.native(),
+		gbool(enabled))
+}
+
+// DrawCompositingIndicators is a wrapper around
+// webkit_settings_get_draw_compositing_indicators().
+func (s *Settings) DrawCompositingIndicators() bool {
+	c := C.webkit_settings_get_draw_compositing_indicators(s.native())
+	return gobool(c)
+}
+
+// SetDrawCompositingIndicators is a wrapper around
+// webkit_settings_set_draw_compositing_indicators().
+func (s *Settings) SetDrawCompositingIndicators(enabled bool) {
+	C.webkit_settings_set_draw_compositing_indicators(s.native(),
+		gbool(enabled))
+}
+
+// EnableSiteSpecificQuirks is a wrapper around
+// webkit_settings_get_enable_site_specific_quirks().
+func (s *Settings) EnableSiteSpecificQuirks() bool {
+	c := C.webkit_settings_get_enable_site_specific_quirks(s.native())
+	return gobool(c)
+}
+
+// SetEnableSiteSpecificQuirks is a wrapper around
+// webkit_settings_set_enable_site_specific_quirks().
+func (s *Settings) SetEnableSiteSpecificQuirks(enabled bool) {
+	C.webkit_settings_set_enable_site_specific_quirks(s.native(),
+		gbool(enabled))
+}
+
+// EnablePageCache is a wrapper around webkit_settings_get_enable_page_cache().
+func (s *Settings) EnablePageCache() bool {
+	c := C.webkit_settings_get_enable_page_cache(s.native())
+	return gobool(c)
+}
+
+// SetEnablePageCache is a wrapper around
+// webkit_settings_set_enable_page_cache().
+func (s *Settings) SetEnablePageCache(enabled bool) {
+	C.webkit_settings_set_enable_page_cache(s.native(), gbool(enabled))
+}
+
+// UserAgent is a wrapper around webkit_settings_get_user_agent().
+func (s *Settings) UserAgent() string {
+	c := C.webkit_settings_get_user_agent(s.native())
+	return C.GoString((*C.char)(c))
+}
+
+// SetUserAgent is a wrapper around webkit_settings_set_user_agent().
+func (s *Settings) SetUserAgent(userAgent string) {
+	cstr := C.CString(userAgent)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_settings_set_user_agent(s.native(), (*C.gchar)(cstr))
+}
+
+// EnableSmoothScrolling is a wrapper around
+// webkit_settings_get_enable_smooth_scrolling().
+func (s *Settings) EnableSmoothScrolling() bool {
+	c := C.webkit_settings_get_enable_smooth_scrolling(s.native())
+	return gobool(c)
+}
+
+// SetEnableSmoothScrolling is a wrapper around
+// webkit_settings_set_enable_smooth_scrolling().
+func (s *Settings) SetEnableSmoothScrolling(enabled bool) {
+	C.webkit_settings_set_enable_smooth_scrolling(s.native(),
+		gbool(enabled))
+}
+
+// EnableAccelerated2DCanvas is a wrapper around
+// webkit_settings_get_enable_accelerated_2d_canvas().
+func (s *Settings) EnableAccelerated2DCanvas() bool {
+	c := C.webkit_settings_get_enable_accelerated_2d_canvas(s.native())
+	return gobool(c)
+}
+
+// SetEnableAccelerated2DCanvas is a wrapper around
+// webkit_settings_set_enable_accelerated_2d_canvas().
+func (s *Settings) SetEnableAccelerated2DCanvas(enabled bool) {
+	C.webkit_settings_set_enable_accelerated_2d_canvas(s.native(),
+		gbool(enabled))
+}
+
+// EnableWriteConsoleMessagesToStdout is a wrapper around
+// webkit_settings_get_enable_write_console_messages_to_stdout().
+func (s *Settings) EnableWriteConsoleMessagesToStdout() bool {
+	c := C.webkit_settings_get_enable_write_console_messages_to_stdout(s.native())
+	return gobool(c)
 }
 
-// native returns a pointer to the underlying WebKitFaviconDatabase.
-func (d *FaviconDatabase) native() *C.WebKitFaviconDatabase {
-	if d == nil || d.GObject == nil {
-		return nil
+// SetEnableWriteConsoleMessagesToStdout is a wrapper around
+// webkit_settings_set_enable_write_console_messages_to_stdout().
+func (s *Settings) SetEnableWriteConsoleMessagesToStdout(enabled bool) {
+	C.webkit_settings_set_enable_write_console_messages_to_stdout(s.native(),
+		gbool(enabled))
+}
+
+// EnableMediaStream is a wrapper around
+// webkit_settings_get_enable_media_stream().
+func (s *Settings) EnableMediaStream() bool {
+	c := C.webkit_settings_get_enable_media_stream(s.native())
+	return gobool(c)
+}
+
+// SetEnableMediaStream is a wrapper around
+// webkit_settings_set_enable_media_stream().
+func (s *Settings) SetEnableMediaStream(enabled bool) {
+	C.webkit_settings_set_enable_media_stream(s.native(), gbool(enabled))
+}
+
+// EnableSpatialNavigation is a wrapper around
+// webkit_settings_get_enable_spatial_navigation().
+func (s *Settings) EnableSpatialNavigation() bool {
+	c := C.webkit_settings_get_enable_spatial_navigation(s.native())
+	return gobool(c)
+}
+
+// SetEnableSpatialNavigation is a wrapper around
+// webkit_settings_set_enable_spatial_navigation().
+func (s *Settings) SetEnableSpatialNavigation(enabled bool) {
+	C.webkit_settings_set_enable_spatial_navigation(s.native(),
+		gbool(enabled))
+}
+
+// EnableMediaSource is a wrapper around
+// webkit_settings_get_enable_mediasource().
+func (s *Settings) EnableMediaSource() bool {
+	c := C.webkit_settings_get_enable_mediasource(s.native())
+	return gobool(c)
+}
+
+// SetEnableMediaSource is a wrapper around
+// webkit_settings_set_enable_mediasource().
+func (s *Settings) SetEnableMediaSource(enabled bool) {
+	C.webkit_settings_set_enable_mediasource(s.native(), gbool(enabled))
+}
+
+// SetUserAgentWithApplicationDetails is a wrapper around
+// webkit_settings_set_user_agent_with_application_details().
+func (s *Settings) SetUserAgentWithApplicationDetails(appName, appVersion string) {
+	cName := C.CString(appName)
+	cVersion := C.CString(appVersion)
+	defer C.free(unsafe.Pointer(cName))
+	defer C.free(unsafe.Pointer(cVersion))
+	C.webkit_settings_set_user_agent_with_application_details(s.native(),
+		(*C.gchar)(cName), (*C.gchar)(cVersion))
+}
+
+// SettingsConfig describes many Settings properties at once, to be set
+// with Settings.Apply.  Each field corresponds to the Settings method of
+// the same name.  Nil fields leave the property unchanged.
+type SettingsConfig struct {
+	EnableJavaScript                      *bool
+	AutoLoadImages                        *bool
+	LoadIconsIgnoringImageLoadSetting     *bool
+	EnableOfflineWebApplicationCache      *bool
+	EnableHTML5LocalStorage               *bool
+	EnableHTML5Database                   *bool
+	EnableXSSAuditor                      *bool
+	EnableFrameFlattening                 *bool
+	EnablePlugins                         *bool
+	EnableJava                            *bool
+	JavaScriptCanOpenWindowsAutomatically *bool
+	EnableHyperlinkAuditing               *bool
+	DefaultFontFamily                     *string
+	MonospaceFontFamily                   *string
+	SerifFontFamily                       *string
+	SansSerifFontFamily                   *string
+	CursiveFontFamily                     *string
+	FantasyFontFamily                     *string
+	PictographFontFamily                  *string
+	DefaultFontSize                       *uint
+	DefaultMonospaceFontSize              *uint
+	MinimumFontSize                       *uint
+	DefaultCharset                        *string
+	EnablePrivateBrowsing                 *bool
+	EnableDeveloperExtras                 *bool
+	EnableResizableTextAreas              *bool
+	EnableTabsToLinks                     *bool
+	EnableDNSPrefetching                  *bool
+	EnableCaretBrowsing                   *bool
+	EnableFullscreen                      *bool
+	PrintBackgrounds                      *bool
+	EnableWebAudio                        *bool
+	EnableWebGL                           *bool
+	AllowModalDialogs                     *bool
+	ZoomTextOnly                          *bool
+	JavaScriptCanAccessClipboard          *bool
+	MediaPlaybackRequiresUserGesture      *bool
+	MediaPlaybackAllowsInline             *bool
+	DrawCompositingIndicators             *bool
+	EnableSiteSpecificQuirks              *bool
+	EnablePageCache                       *bool
+	UserAgent                             *string
+	EnableSmoothScrolling                 *bool
+	EnableAccelerated2DCanvas             *bool
+	EnableWriteConsoleMessagesToStdout    *bool
+	EnableMediaStream                     *bool
+	EnableSpatialNavigation               *bool
+	EnableMediaSource                     *bool
+}
+
+// Apply sets each property of the Settings with a non-nil field in c.
+func (s *Settings) Apply(c SettingsConfig) {
+	if c.EnableJavaScript != nil {
+		s.SetEnableJavaScript(*c.EnableJavaScript)
+	}
+	if c.AutoLoadImages != nil {
+		s.SetAutoLoadImages(*c.AutoLoadImages)
+	}
+	if c.LoadIconsIgnoringImageLoadSetting != nil {
+		s.SetLoadIconsIgnoringImageLoadSetting(*c.LoadIconsIgnoringImageLoadSetting)
+	}
+	if c.EnableOfflineWebApplicationCache != nil {
+		s.SetEnableOfflineWebApplicationCache(*c.EnableOfflineWebApplicationCache)
+	}
+	if c.EnableHTML5LocalStorage != nil {
+		s.SetEnableHTML5LocalStorage(*c.EnableHTML5LocalStorage)
+	}
+	if c.EnableHTML5Database != nil {
+		s.SetEnableHTML5Database(*c.EnableHTML5Database)
+	}
+	if c.EnableXSSAuditor != nil {
+		s.SetEnableXSSAuditor(*c.EnableXSSAuditor)
+	}
+	if c.EnableFrameFlattening != nil {
+		s.SetEnableFrameFlattening(*c.EnableFrameFlattening)
+	}
+	if c.EnablePlugins != nil {
+		s.SetEnablePlugins(*c.EnablePlugins)
+	}
+	if c.EnableJava != nil {
+		s.SetEnableJava(*c.EnableJava)
+	}
+	if c.JavaScriptCanOpenWindowsAutomatically != nil {
+		s.SetJavaScriptCanOpenWindowsAutomatically(*c.JavaScriptCanOpenWindowsAutomatically)
+	}
+	if c.EnableHyperlinkAuditing != nil {
+		s.SetEnableHyperlinkAuditing(*c.EnableHyperlinkAuditing)
+	}
+	if c.DefaultFontFamily != nil {
+		s.SetDefaultFontFamily(*c.DefaultFontFamily)
+	}
+	if c.MonospaceFontFamily != nil {
+		s.SetMonospaceFontFamily(*c.MonospaceFontFamily)
+	}
+	if c.SerifFontFamily != nil {
+		s.SetSerifFontFamily(*c.SerifFontFamily)
+	}
+	if c.SansSerifFontFamily != nil {
+		s.SetSansSerifFontFamily(*c.SansSerifFontFamily)
+	}
+	if c.CursiveFontFamily != nil {
+		s.SetCursiveFontFamily(*c.CursiveFontFamily)
+	}
+	if c.FantasyFontFamily != nil {
+		s.SetFantasyFontFamily(*c.FantasyFontFamily)
+	}
+	if c.PictographFontFamily != nil {
+		s.SetPictographFontFamily(*c.PictographFontFamily)
+	}
+	if c.DefaultFontSize != nil {
+		s.SetDefaultFontSize(*c.DefaultFontSize)
+	}
+	if c.DefaultMonospaceFontSize != nil {
+		s.SetDefaultMonospaceFontSize(*c.DefaultMonospaceFontSize)
+	}
+	if c.MinimumFontSize != nil {
+		s.SetMinimumFontSize(*c.MinimumFontSize)
+	}
+	if c.DefaultCharset != nil {
+		s.SetDefaultCharset(*c.DefaultCharset)
+	}
+	if c.EnablePrivateBrowsing != nil {
+		s.SetEnablePrivateBrowsing(*c.EnablePrivateBrowsing)
+	}
+	if c.EnableDeveloperExtras != nil {
+		s.SetEnableDeveloperExtras(*c.EnableDeveloperExtras)
+	}
+	if c.EnableResizableTextAreas != nil {
+		s.SetEnableResizableTextAreas(*c.EnableResizableTextAreas)
+	}
+	if c.EnableTabsToLinks != nil {
+		s.SetEnableTabsToLinks(*c.EnableTabsToLinks)
+	}
+	if c.EnableDNSPrefetching != nil {
+		s.SetEnableDNSPrefetching(*c.EnableDNSPrefetching)
+	}
+	if c.EnableCaretBrowsing != nil {
+		s.SetEnableCaretBrowsing(*c.EnableCaretBrowsing)
+	}
+	if c.EnableFullscreen != nil {
+		s.SetEnableFullscreen(*c.EnableFullscreen)
+	}
+	if c.PrintBackgrounds != nil {
+		s.SetPrintBackgrounds(*c.PrintBackgrounds)
+	}
+	if c.EnableWebAudio != nil {
+		s.SetEnableWebAudio(*c.EnableWebAudio)
+	}
+	if c.EnableWebGL != nil {
+		s.SetEnableWebGL(*c.EnableWebGL)
+	}
+	if c.AllowModalDialogs != nil {
+		s.SetAllowModalDialogs(*c.AllowModalDialogs)
+	}
+	if c.ZoomTextOnly != nil {
+		s.SetZoomTextOnly(*c.ZoomTextOnly)
+	}
+	if c.JavaScriptCanAccessClipboard != nil {
+		s.SetJavaScriptCanAccessClipboard(*c.JavaScriptCanAccessClipboard)
+	}
+	if c.MediaPlaybackRequiresUserGesture != nil {
+		s.SetMediaPlaybackRequiresUserGesture(*c.MediaPlaybackRequiresUserGesture)
+	}
+	if c.MediaPlaybackAllowsInline != nil {
+		s.SetMediaPlaybackAllowsInline(*c.MediaPlaybackAllowsInline)
+	}
+	if c.DrawCompositingIndicators != nil {
+		s.SetDrawCompositingIndicators(*c.DrawCompositingIndicators)
+	}
+	if c.EnableSiteSpecificQuirks != nil {
+		s.SetEnableSiteSpecificQuirks(*c.EnableSiteSpecificQuirks)
+	}
+	if c.EnablePageCache != nil {
+		s.SetEnablePageCache(*c.EnablePageCache)
+	}
+	if c.UserAgent != nil {
+		s.SetUserAgent(*c.UserAgent)
+	}
+	if c.EnableSmoothScrolling != nil {
+		s.SetEnableSmoothScrolling(*c.EnableSmoothScrolling)
+	}
+	if c.EnableAccelerated2DCanvas != nil {
+		s.SetEnableAccelerated2DCanvas(*c.EnableAccelerated2DCanvas)
+	}
+	if c.EnableWriteConsoleMessagesToStdout != nil {
+		s.SetEnableWriteConsoleMessagesToStdout(*c.EnableWriteConsoleMessagesToStdout)
+	}
+	if c.EnableMediaStream != nil {
+		s.SetEnableMediaStream(*c.EnableMediaStream)
+	}
+	if c.EnableSpatialNavigation != nil {
+		s.SetEnableSpatialNavigation(*c.EnableSpatialNavigation)
+	}
+	if c.EnableMediaSource != nil {
+		s.SetEnableMediaSource(*c.EnableMediaSource)
 	}
-	p := unsafe.Pointer(d.GObject)
-	return C.toWebKitFaviconDatabase(p)
 }
 
 //
-// WebKitSecurityManager
+// GTlsCertificate
 //
 
-// SecurityManager is a representation of WebKit2GTK+'s WebKitSecurityManager.
-type SecurityManager struct {
+// TLSCertificate is a representation of GIO's GTlsCertificate.
+type TLSCertificate struct {
 	*glib.Object
 }
 
-func marshalSecurityManager(p uintptr) (interface{}, error) {
+func marshalTLSCertificate(p uintptr) (interface{}, error) {
 	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
 	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
-	return wrapSecurityManager(obj), nil
+	return wrapTLSCertificate(obj), nil
 }
 
-func wrapSecurityManager(obj *glib.Object) *SecurityManager {
-	return &SecurityManager{obj}
+func wrapTLSCertificate(obj *glib.Object) *TLSCertificate {
+	return &TLSCertificate{obj}
 }
 
-// native returns a pointer to the underlying WebKitSecurityManager.
-func (s *SecurityManager) native() *C.WebKitSecurityManager {
-	if s == nil || s.GObject == nil {
+// native returns a pointer to the underlying GTlsCertificate.
+func (c *TLSCertificate) native() *C.GTlsCertificate {
+	if c == nil || c.GObject == nil {
 		return nil
 	}
-	p := unsafe.Pointer(s.GObject)
-	return C.toWebKitSecurityManager(p)
+	p := unsafe.Pointer(c.GObject)
+	return C.toGTlsCertificate(p)
+}
+
+// Issuer is a wrapper around g_tls_certificate_get_issuer().
+func (c *TLSCertificate) Issuer() *TLSCertificate {
+	issuer := C.g_tls_certificate_get_issuer(c.native())
+	if issuer == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(issuer))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapTLSCertificate(obj)
+}
+
+// DER returns the DER encoding of the certificate, as held by the
+// GTlsCertificate's certificate property.
+func (c *TLSCertificate) DER() []byte {
+	der := C.tlsCertificateDER(c.native())
+	if der == nil {
+		return nil
+	}
+	defer C.g_byte_array_unref(der)
+	return C.GoBytes(unsafe.Pointer(der.data), C.int(der.len))
+}
+
+// X509 parses the certificate as a crypto/x509 certificate.
+func (c *TLSCertificate) X509() (*x509.Certificate, error) {
+	der := c.DER()
+	if der == nil {
+		return nil, errors.New("wk2: TLS certificate has no DER encoding")
+	}
+	return x509.ParseCertificate(der)
 }
 
 //
@@ -433,6 +3314,74 @@ func NewURIRequest(uri string) *URIRequest {
 	return wrapURIRequest(obj)
 }
 
+// URI is a wrapper around webkit_uri_request_get_uri().
+func (r *URIRequest) URI() string {
+	c := C.webkit_uri_request_get_uri(r.native())
+	return C.GoString((*C.char)(c))
+}
+
+//
+// WebKitURIResponse
+//
+
+// URIResponse is a representation of WebKit2GTK+'s WebKitURIResponse.
+// There is no accessor for the response's HTTP headers, as
+// webkit_uri_response_get_http_headers() was added in WebKit2GTK+ 2.6.
+type URIResponse struct {
+	*glib.Object
+}
+
+func marshalURIResponse(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapURIResponse(obj), nil
+}
+
+func wrapURIResponse(obj *glib.Object) *URIResponse {
+	return &URIResponse{obj}
+}
+
+// native returns a pointer to the underlying WebKitURIResponse.
+func (r *URIResponse) native() *C.WebKitURIResponse {
+	if r == nil || r.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(r.GObject)
+	return C.toWebKitURIResponse(p)
+}
+
+// URI is a wrapper around webkit_uri_response_get_uri().
+func (r *URIResponse) URI() string {
+	c := C.webkit_uri_response_get_uri(r.native())
+	return C.GoString((*C.char)(c))
+}
+
+// MIMEType is a wrapper around webkit_uri_response_get_mime_type().
+func (r *URIResponse) MIMEType() string {
+	c := C.webkit_uri_response_get_mime_type(r.native())
+	return C.GoString((*C.char)(c))
+}
+
+// StatusCode is a wrapper around webkit_uri_response_get_status_code().
+func (r *URIResponse) StatusCode() uint {
+	c := C.webkit_uri_response_get_status_code(r.native())
+	return uint(c)
+}
+
+// ContentLength is a wrapper around
+// webkit_uri_response_get_content_length().
+func (r *URIResponse) ContentLength() uint64 {
+	c := C.webkit_uri_response_get_content_length(r.native())
+	return uint64(c)
+}
+
+// SuggestedFilename is a wrapper around
+// webkit_uri_response_get_suggested_filename().
+func (r *URIResponse) SuggestedFilename() string {
+	c := C.webkit_uri_response_get_suggested_filename(r.native())
+	return C.GoString((*C.char)(c))
+}
+
 //
 // WebKitWebContext
 //
@@ -660,28 +3609,332 @@ func (w *WebContext) SetDiskCacheDirectory(dir string) {
 	C.webkit_web_context_set_disk_cache_directory(w.native(), (*C.gchar)(cstr))
 }
 
-// AllowTLSCertificateForHost is a wrapper around
-// webkit_web_context_allow_tls_certificate_for_host().
-func (w *WebContext) AllowTLSCertificateForHost(info *CertificateInfo, host string) {
-	cstr := C.CString(host)
-	defer C.free(unsafe.Pointer(cstr))
-	C.webkit_web_context_allow_tls_certificate_for_host(w.native(),
-		info.native(), (*C.gchar)(cstr))
+// AllowTLSCertificateForHost is a wrapper around
+// webkit_web_context_allow_tls_certificate_for_host().
+func (w *WebContext) AllowTLSCertificateForHost(info *CertificateInfo, host string) {
+	cstr := C.CString(host)
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_web_context_allow_tls_certificate_for_host(w.native(),
+		info.native(), (*C.gchar)(cstr))
+}
+
+// ProcessModel is a wrapper around webkit_web_context_get_process_model().
+func (w *WebContext) ProcessModel() ProcessModel {
+	c := C.webkit_web_context_get_process_model(w.native())
+	return ProcessModel(c)
+}
+
+// SetProcessModel is a wrapper around webkit_web_context_set_process_model().
+func (w *WebContext) SetProcessModel(model ProcessModel) {
+	C.webkit_web_context_set_process_model(w.native(),
+		C.WebKitProcessModel(model))
+}
+
+// credentialProviders holds the CredentialProvider of each WebContext
+// which has one set, keyed by an ID stored as data of the
+// WebKitWebContext.  An entry is removed when its WebContext is finalized
+// or is given another provider.
+var credentialProviders = struct {
+	sync.Mutex
+	m    map[uint]CredentialProvider
+	next uint
+}{
+	m:    make(map[uint]CredentialProvider),
+	next: 1,
+}
+
+//export goCredentialProviderDestroy
+func goCredentialProviderDestroy(data C.gpointer) {
+	id := uint(C.gpointerToUint(data))
+	credentialProviders.Lock()
+	delete(credentialProviders.m, id)
+	credentialProviders.Unlock()
+}
+
+// SetCredentialProvider sets the CredentialProvider answering the HTTP
+// authentication requests of every WebView of the WebContext that was
+// created by this package; see NewWebView.  A nil provider removes the
+// WebContext's provider.
+func (w *WebContext) SetCredentialProvider(p CredentialProvider) {
+	if p == nil {
+		C.setCredentialProvider(w.native(), 0)
+		return
+	}
+	credentialProviders.Lock()
+	id := credentialProviders.next
+	credentialProviders.next++
+	credentialProviders.m[id] = p
+	credentialProviders.Unlock()
+	C.setCredentialProvider(w.native(), C.guint(id))
+}
+
+// CredentialProvider returns the CredentialProvider set for the
+// WebContext, or nil if none is set.
+func (w *WebContext) CredentialProvider() CredentialProvider {
+	id := uint(C.credentialProvider(w.native()))
+	if id == 0 {
+		return nil
+	}
+	credentialProviders.Lock()
+	p := credentialProviders.m[id]
+	credentialProviders.Unlock()
+	return p
+}
+
+// TODO: webkit_web_context_register_uri_scheme
+
+//
+// WebKitWebInspector
+//
+
+// WebInspector is a representation of WebKit2GTK+'s WebKitWebInspector.
+type WebInspector struct {
+	*glib.Object
+}
+
+func marshalWebInspector(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapWebInspector(obj), nil
+}
+
+func wrapWebInspector(obj *glib.Object) *WebInspector {
+	return &WebInspector{obj}
+}
+
+// native returns a pointer to the underlying WebKitWebInspector.
+func (i *WebInspector) native() *C.WebKitWebInspector {
+	if i == nil || i.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(i.GObject)
+	return C.toWebKitWebInspector(p)
+}
+
+// EnableRemoteInspector enables remote inspection of all web processes
+// started after it is called, with the inspector server listening on
+// addr (a host and port, such as "127.0.0.1:9222").  The inspector is
+// reached by loading the address in another browser.  This sets the
+// WEBKIT_INSPECTOR_SERVER environment variable read by WebKit2GTK+, so it
+// must be called before any WebView is created.
+func EnableRemoteInspector(addr string) error {
+	return os.Setenv("WEBKIT_INSPECTOR_SERVER", addr)
+}
+
+// WebView is a wrapper around webkit_web_inspector_get_web_view().  The
+// returned widget is the WebKitWebViewBase displaying the inspector, or
+// nil if the inspector has not been loaded.
+func (i *WebInspector) WebView() *gtk.Widget {
+	c := C.webkit_web_inspector_get_web_view(i.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return &gtk.Widget{InitiallyUnowned: glib.InitiallyUnowned{Object: obj}}
+}
+
+// InspectedURI is a wrapper around webkit_web_inspector_get_inspected_uri().
+func (i *WebInspector) InspectedURI() string {
+	c := C.webkit_web_inspector_get_inspected_uri(i.native())
+	return C.GoString((*C.char)(c))
+}
+
+// IsAttached is a wrapper around webkit_web_inspector_is_attached().
+func (i *WebInspector) IsAttached() bool {
+	c := C.webkit_web_inspector_is_attached(i.native())
+	return gobool(c)
+}
+
+// Attach is a wrapper around webkit_web_inspector_attach().
+func (i *WebInspector) Attach() {
+	C.webkit_web_inspector_attach(i.native())
+}
+
+// Detach is a wrapper around webkit_web_inspector_detach().
+func (i *WebInspector) Detach() {
+	C.webkit_web_inspector_detach(i.native())
+}
+
+// Show is a wrapper around webkit_web_inspector_show().
+func (i *WebInspector) Show() {
+	C.webkit_web_inspector_show(i.native())
+}
+
+// Close is a wrapper around webkit_web_inspector_close().
+func (i *WebInspector) Close() {
+	C.webkit_web_inspector_close(i.native())
+}
+
+// AttachedHeight is a wrapper around
+// webkit_web_inspector_get_attached_height().
+func (i *WebInspector) AttachedHeight() uint {
+	c := C.webkit_web_inspector_get_attached_height(i.native())
+	return uint(c)
+}
+
+// OnOpenWindow connects f to the WebInspector's open-window signal.  f is
+// called when the inspector is to be shown in a window of its own.  If f
+// returns true, the signal is handled and the default window is not
+// created.
+func (i *WebInspector) OnOpenWindow(f func() bool) (glib.SignalHandle, error) {
+	return i.Connect("open-window", func(_ *WebInspector) bool {
+		return f()
+	})
+}
+
+// OnBringToFront connects f to the WebInspector's bring-to-front signal.
+// If f returns true, the signal is handled and the default window is not
+// presented.
+func (i *WebInspector) OnBringToFront(f func() bool) (glib.SignalHandle, error) {
+	return i.Connect("bring-to-front", func(_ *WebInspector) bool {
+		return f()
+	})
+}
+
+// OnAttach connects f to the WebInspector's attach signal.  f is called
+// when the inspector is to be attached to the inspected WebView's window.
+// If f returns true, the signal is handled and the inspector is not
+// attached by default.
+func (i *WebInspector) OnAttach(f func() bool) (glib.SignalHandle, error) {
+	return i.Connect("attach", func(_ *WebInspector) bool {
+		return f()
+	})
+}
+
+// OnDetach connects f to the WebInspector's detach signal.  f is called
+// when the inspector is to be detached from the inspected WebView's
+// window.  If f returns true, the signal is handled and the inspector is
+// not detached by default.
+func (i *WebInspector) OnDetach(f func() bool) (glib.SignalHandle, error) {
+	return i.Connect("detach", func(_ *WebInspector) bool {
+		return f()
+	})
+}
+
+// OnClosed connects f to the WebInspector's closed signal.  f is called
+// when the inspector is closed.
+func (i *WebInspector) OnClosed(f func()) (glib.SignalHandle, error) {
+	return i.Connect("closed", func(_ *WebInspector) {
+		f()
+	})
+}
+
+//
+// WebKitWebResource
+//
+
+// WebResource is a representation of WebKit2GTK+'s WebKitWebResource.
+type WebResource struct {
+	*glib.Object
+}
+
+func marshalWebResource(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapWebResource(obj), nil
+}
+
+func wrapWebResource(obj *glib.Object) *WebResource {
+	return &WebResource{obj}
+}
+
+// native returns a pointer to the underlying WebKitWebResource.
+func (r *WebResource) native() *C.WebKitWebResource {
+	if r == nil || r.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(r.GObject)
+	return C.toWebKitWebResource(p)
+}
+
+// URI is a wrapper around webkit_web_resource_get_uri().
+func (r *WebResource) URI() string {
+	c := C.webkit_web_resource_get_uri(r.native())
+	return C.GoString((*C.char)(c))
+}
+
+// Response is a wrapper around webkit_web_resource_get_response().
+func (r *WebResource) Response() *URIResponse {
+	c := C.webkit_web_resource_get_response(r.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapURIResponse(obj)
+}
+
+// Data is a wrapper around webkit_web_resource_get_data() and
+// webkit_web_resource_get_data_finish().
+func (r *WebResource) Data(ctx context.Context) ([]byte, error) {
+	var b []byte
+	err := runAsync(ctx, func(cancellable *C.GCancellable,
+		callback C.GAsyncReadyCallback, data C.gpointer) {
+
+		C.webkit_web_resource_get_data(r.native(), cancellable, callback,
+			data)
+	}, func(res *C.GAsyncResult) error {
+		var length C.gsize
+		var gerr *C.GError
+		c := C.webkit_web_resource_get_data_finish(r.native(), res,
+			&length, &gerr)
+		if gerr != nil {
+			return goError(gerr)
+		}
+		defer C.g_free(C.gpointer(unsafe.Pointer(c)))
+		b = C.GoBytes(unsafe.Pointer(c), C.int(length))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// OnSentRequest connects f to the WebResource's sent-request signal.  f
+// is called with each request sent for the resource, and the response
+// causing the request if it is a redirect, or nil.
+func (r *WebResource) OnSentRequest(f func(request *URIRequest, redirectedResponse *URIResponse)) (glib.SignalHandle, error) {
+	return r.Connect("sent-request", func(_ *WebResource,
+		request *URIRequest, redirected *URIResponse) {
+
+		if redirected.native() == nil {
+			redirected = nil
+		}
+		f(request, redirected)
+	})
 }
 
-// ProcessModel is a wrapper around webkit_web_context_get_process_model().
-func (w *WebContext) ProcessModel() ProcessModel {
-	c := C.webkit_web_context_get_process_model(w.native())
-	return ProcessModel(c)
+// OnReceivedData connects f to the WebResource's received-data signal.
+// f is called with the length of each chunk of data received.
+func (r *WebResource) OnReceivedData(f func(length uint64)) (glib.SignalHandle, error) {
+	return r.Connect("received-data", func(_ *WebResource, length uint64) {
+		f(length)
+	})
 }
 
-// SetProcessModel is a wrapper around webkit_web_context_set_process_model().
-func (w *WebContext) SetProcessModel(model ProcessModel) {
-	C.webkit_web_context_set_process_model(w.native(),
-		C.WebKitProcessModel(model))
+// OnFinished connects f to the WebResource's finished signal.  f is
+// called when the resource has finished loading.
+func (r *WebResource) OnFinished(f func()) (glib.SignalHandle, error) {
+	return r.Connect("finished", func(_ *WebResource) {
+		f()
+	})
 }
 
-// TODO: webkit_web_context_register_uri_scheme
+// OnFailed connects f to the WebResource's failed signal.  f is called
+// with the error that caused the resource to fail to load.
+func (r *WebResource) OnFailed(f func(err error)) (glib.SignalHandle, error) {
+	return r.Connect("failed", func(_ *WebResource, p unsafe.Pointer) {
+		var err error
+		if gerr := (*C.GError)(p); gerr != nil {
+			err = errors.New(C.GoString((*C.char)(gerr.message)))
+		}
+		f(err)
+	})
+}
 
 //
 // WebKitWebView
@@ -712,6 +3965,13 @@ func (w *WebView) native() *C.WebKitWebView {
 }
 
 // NewWebView is a wrapper around webkit_web_view_new().
+//
+// WebViews created by this or any other constructor of this package have
+// their authenticate signal connected to the CredentialProvider of their
+// WebContext, if one is set when the signal is emitted.  As this handler
+// is connected before any handler connected by the caller, the provider
+// answers authentication requests first, and handlers connected with
+// OnAuthenticate only receive the requests the provider does not handle.
 func NewWebView() *WebView {
 	c := C.webkit_web_view_new()
 	if c == nil {
@@ -720,7 +3980,9 @@ func NewWebView() *WebView {
 	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
 	obj.RefSink()
 	runtime.SetFinalizer(obj, (*glib.Object).Unref)
-	return wrapWebView(obj)
+	w := wrapWebView(obj)
+	w.connectCredentialProvider()
+	return w
 }
 
 // NewWebViewWithContext is a wrapper around webkit_web_view_new_with_context().
@@ -732,7 +3994,9 @@ func NewWebViewWithContext(context *WebContext) *WebView {
 	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
 	obj.RefSink()
 	runtime.SetFinalizer(obj, (*glib.Object).Unref)
-	return wrapWebView(obj)
+	w := wrapWebView(obj)
+	w.connectCredentialProvider()
+	return w
 }
 
 // NewWebViewWithGroup is a wrapper around webkit_web_view_new_with_group().
@@ -744,7 +4008,47 @@ func NewWebViewWithGroup(group *WebViewGroup) *WebView {
 	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
 	obj.RefSink()
 	runtime.SetFinalizer(obj, (*glib.Object).Unref)
-	return wrapWebView(obj)
+	w := wrapWebView(obj)
+	w.connectCredentialProvider()
+	return w
+}
+
+// NewWebViewWithRelatedView is a wrapper around
+// webkit_web_view_new_with_related_view().
+func NewWebViewWithRelatedView(view *WebView) *WebView {
+	c := C.webkit_web_view_new_with_related_view(view.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	w := wrapWebView(obj)
+	w.connectCredentialProvider()
+	return w
+}
+
+// connectCredentialProvider connects the WebView's authenticate signal
+// to the CredentialProvider of its WebContext.  The provider is looked up
+// each time the signal is emitted, so a provider set, replaced or removed
+// after the WebView is created is respected.
+func (w *WebView) connectCredentialProvider() {
+	w.Connect("authenticate", func(v *WebView, r *AuthenticationRequest) bool {
+		p := v.Context().CredentialProvider()
+		if p == nil {
+			return false
+		}
+		credential, ok := p.Credential(r)
+		if !ok {
+			return false
+		}
+		if credential == nil {
+			r.Cancel()
+		} else {
+			r.Authenticate(credential)
+		}
+		return true
+	})
 }
 
 // Context is a wrapper around webkit_web_view_get_context().
@@ -889,6 +4193,828 @@ func (w *WebView) URI() string {
 	return C.GoString((*C.char)(c))
 }
 
+// FindController is a wrapper around webkit_web_view_get_find_controller().
+func (w *WebView) FindController() *FindController {
+	c := C.webkit_web_view_get_find_controller(w.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapFindController(obj)
+}
+
+// Settings is a wrapper around webkit_web_view_get_settings().
+func (w *WebView) Settings() *Settings {
+	c := C.webkit_web_view_get_settings(w.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapSettings(obj)
+}
+
+// SetSettings is a wrapper around webkit_web_view_set_settings().
+func (w *WebView) SetSettings(settings *Settings) {
+	C.webkit_web_view_set_settings(w.native(), settings.native())
+}
+
+// ZoomLevel is a wrapper around webkit_web_view_get_zoom_level().
+func (w *WebView) ZoomLevel() float64 {
+	c := C.webkit_web_view_get_zoom_level(w.native())
+	return float64(c)
+}
+
+// SetZoomLevel is a wrapper around webkit_web_view_set_zoom_level().
+func (w *WebView) SetZoomLevel(level float64) {
+	C.webkit_web_view_set_zoom_level(w.native(), C.gdouble(level))
+}
+
+// ZoomTextOnly returns whether the zoom level of the WebView only
+// affects text.  This is a shortcut for w.Settings().ZoomTextOnly().
+func (w *WebView) ZoomTextOnly() bool {
+	return w.Settings().ZoomTextOnly()
+}
+
+// SetZoomTextOnly sets whether the zoom level of the WebView only
+// affects text.  This is a shortcut for w.Settings().SetZoomTextOnly().
+func (w *WebView) SetZoomTextOnly(enabled bool) {
+	w.Settings().SetZoomTextOnly(enabled)
+}
+
+// WindowProperties is a wrapper around
+// webkit_web_view_get_window_properties().
+func (w *WebView) WindowProperties() *WindowProperties {
+	c := C.webkit_web_view_get_window_properties(w.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapWindowProperties(obj)
+}
+
+// Snapshot is a wrapper around webkit_web_view_get_snapshot() and
+// webkit_web_view_get_snapshot_finish().  The snapshot is returned as an
+// *image.RGBA.
+func (w *WebView) Snapshot(ctx context.Context, region SnapshotRegion, options SnapshotOptions) (image.Image, error) {
+	var img *image.RGBA
+	err := runAsync(ctx, func(cancellable *C.GCancellable,
+		callback C.GAsyncReadyCallback, data C.gpointer) {
+
+		C.webkit_web_view_get_snapshot(w.native(),
+			C.WebKitSnapshotRegion(region),
+			C.WebKitSnapshotOptions(options), cancellable, callback,
+			data)
+	}, func(res *C.GAsyncResult) error {
+		var gerr *C.GError
+		surface := C.webkit_web_view_get_snapshot_finish(w.native(), res,
+			&gerr)
+		if surface == nil {
+			return goError(gerr)
+		}
+		defer C.cairo_surface_destroy(surface)
+
+		var err error
+		img, err = imageSurfaceRGBA(surface)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// Save is a wrapper around webkit_web_view_save() and
+// webkit_web_view_save_finish().  The saved page is copied to wr in
+// chunks as it is read from the resulting GInputStream.
+func (w *WebView) Save(ctx context.Context, wr io.Writer, mode SaveMode) error {
+	var r *inputStream
+	err := runAsync(ctx, func(cancellable *C.GCancellable,
+		callback C.GAsyncReadyCallback, data C.gpointer) {
+
+		C.webkit_web_view_save(w.native(), C.WebKitSaveMode(mode),
+			cancellable, callback, data)
+	}, func(res *C.GAsyncResult) error {
+		var gerr *C.GError
+		c := C.webkit_web_view_save_finish(w.native(), res, &gerr)
+		if c == nil {
+			return goError(gerr)
+		}
+		r = newInputStream(c)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	buf := make([]byte, 32*1024)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		n, err := r.Read(buf)
+		if n > 0 {
+			if _, err := wr.Write(buf[:n]); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// SaveToFile is a wrapper around webkit_web_view_save_to_file() and
+// webkit_web_view_save_to_file_finish().
+func (w *WebView) SaveToFile(ctx context.Context, path string, mode SaveMode) error {
+	return runAsync(ctx, func(cancellable *C.GCancellable,
+		callback C.GAsyncReadyCallback, data C.gpointer) {
+
+		cstr := C.CString(path)
+		defer C.free(unsafe.Pointer(cstr))
+		file := C.g_file_new_for_path(cstr)
+		defer C.g_object_unref(C.gpointer(unsafe.Pointer(file)))
+		C.webkit_web_view_save_to_file(w.native(), file,
+			C.WebKitSaveMode(mode), cancellable, callback, data)
+	}, func(res *C.GAsyncResult) error {
+		var gerr *C.GError
+		c := C.webkit_web_view_save_to_file_finish(w.native(), res, &gerr)
+		if !gobool(c) {
+			return goError(gerr)
+		}
+		return nil
+	})
+}
+
+// ExecuteEditingCommand is a wrapper around
+// webkit_web_view_execute_editing_command().
+func (w *WebView) ExecuteEditingCommand(command EditingCommand) {
+	cstr := C.CString(string(command))
+	defer C.free(unsafe.Pointer(cstr))
+	C.webkit_web_view_execute_editing_command(w.native(), (*C.gchar)(cstr))
+}
+
+// CanExecuteEditingCommand is a wrapper around
+// webkit_web_view_can_execute_editing_command() and
+// webkit_web_view_can_execute_editing_command_finish().
+func (w *WebView) CanExecuteEditingCommand(ctx context.Context, command EditingCommand) (bool, error) {
+	var can bool
+	err := runAsync(ctx, func(cancellable *C.GCancellable,
+		callback C.GAsyncReadyCallback, data C.gpointer) {
+
+		cstr := C.CString(string(command))
+		defer C.free(unsafe.Pointer(cstr))
+		C.webkit_web_view_can_execute_editing_command(w.native(),
+			(*C.gchar)(cstr), cancellable, callback, data)
+	}, func(res *C.GAsyncResult) error {
+		var gerr *C.GError
+		c := C.webkit_web_view_can_execute_editing_command_finish(w.native(),
+			res, &gerr)
+		if gerr != nil {
+			return goError(gerr)
+		}
+		can = gobool(c)
+		return nil
+	})
+	return can, err
+}
+
+// TLSInfo is a wrapper around webkit_web_view_get_tls_info().  ok is
+// false if the WebView's current page was not loaded over TLS.
+func (w *WebView) TLSInfo() (cert *TLSCertificate, flags TLSCertificateFlags, ok bool) {
+	var c *C.GTlsCertificate
+	var cflags C.GTlsCertificateFlags
+	if !gobool(C.webkit_web_view_get_tls_info(w.native(), &c, &cflags)) {
+		return nil, 0, false
+	}
+	if c != nil {
+		obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+		obj.RefSink()
+		runtime.SetFinalizer(obj, (*glib.Object).Unref)
+		cert = wrapTLSCertificate(obj)
+	}
+	return cert, TLSCertificateFlags(cflags), true
+}
+
+// MainResource is a wrapper around webkit_web_view_get_main_resource().
+func (w *WebView) MainResource() *WebResource {
+	c := C.webkit_web_view_get_main_resource(w.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapWebResource(obj)
+}
+
+// PageSource returns the source of the WebView's main resource, as loaded
+// from the network or cache, decoded to UTF-8.  The data is decoded from
+// the WebView's custom charset if one is set, or else from the character
+// set declared by the document's byte order mark or meta element.  As
+// WebKit2GTK+ 2.4 does not expose the response's Content-Type header, a
+// document without a declared character set is decoded as UTF-8 if it is
+// valid UTF-8, and an error is returned otherwise.
+func (w *WebView) PageSource(ctx context.Context) (string, error) {
+	if onMainLoop() {
+		return "", errMainLoop
+	}
+
+	// As the main resource and charset are read from the WebView, they
+	// must be read from the main loop.
+	var resource *WebResource
+	var charset string
+	done := make(chan struct{})
+	_, err := glib.IdleAdd(func() bool {
+		resource = w.MainResource()
+		charset = w.CustomCharset()
+		close(done)
+		return false
+	})
+	if err != nil {
+		return "", err
+	}
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+	if resource == nil {
+		return "", errors.New("wk2: web view has no main resource")
+	}
+
+	data, err := resource.Data(ctx)
+	if err != nil {
+		return "", err
+	}
+	charset, err = sourceCharset(charset, data)
+	if err != nil {
+		return "", err
+	}
+
+	var source string
+	if isUTF8Charset(charset) {
+		if !utf8.Valid(data) {
+			return "", errors.New("wk2: page is not valid UTF-8")
+		}
+		source = string(data)
+	} else {
+		source, err = convertToUTF8(data, charset)
+		if err != nil {
+			return "", err
+		}
+	}
+	return strings.TrimPrefix(source, "\ufeff"), nil
+}
+
+// Favicon is a wrapper around webkit_web_view_get_favicon().  The favicon
+// is returned as an *image.RGBA, or nil if the page has no favicon.
+func (w *WebView) Favicon() image.Image {
+	surface := C.webkit_web_view_get_favicon(w.native())
+	if surface == nil {
+		return nil
+	}
+	img, err := imageSurfaceRGBA(surface)
+	if err != nil {
+		return nil
+	}
+	return img
+}
+
+// OnFaviconChanged connects f to the WebView's notify::favicon signal.  f
+// is called with the new favicon, or nil if the page has no favicon.
+func (w *WebView) OnFaviconChanged(f func(favicon image.Image)) (glib.SignalHandle, error) {
+	return w.Connect("notify::favicon", func(v *WebView) {
+		f(v.Favicon())
+	})
+}
+
+// Inspector is a wrapper around webkit_web_view_get_inspector().  The
+// inspector may only be shown if the WebView's settings enable developer
+// extras; see EnableInspector.
+func (w *WebView) Inspector() *WebInspector {
+	c := C.webkit_web_view_get_inspector(w.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapWebInspector(obj)
+}
+
+// EnableInspector enables developer extras in the WebView's settings, and
+// returns the WebView's inspector.
+func (w *WebView) EnableInspector() *WebInspector {
+	w.Settings().SetEnableDeveloperExtras(true)
+	return w.Inspector()
+}
+
+// OnLoadChanged connects f to the WebView's load-changed signal.  f is
+// called with each LoadEvent of the WebView's load operations.
+func (w *WebView) OnLoadChanged(f func(LoadEvent)) (glib.SignalHandle, error) {
+	return w.Connect("load-changed", func(_ *WebView, e LoadEvent) {
+		f(e)
+	})
+}
+
+// OnLoadFailed connects f to the WebView's load-failed signal.  f is
+// called with the LoadEvent during which the load failed, the failing
+// URI, and the error that caused the failure.  If f returns true, the
+// signal is handled and WebKit's default error page is not loaded.
+func (w *WebView) OnLoadFailed(f func(e LoadEvent, uri string, err error) bool) (glib.SignalHandle, error) {
+	return w.Connect("load-failed", func(_ *WebView, e LoadEvent,
+		uri string, p unsafe.Pointer) bool {
+
+		var err error
+		if gerr := (*C.GError)(p); gerr != nil {
+			err = errors.New(C.GoString((*C.char)(gerr.message)))
+		}
+		return f(e, uri, err)
+	})
+}
+
+// OnLoadFailedWithTLSErrors connects f to the WebView's
+// load-failed-with-tls-errors signal.  f is called with the certificate
+// that failed validation and the host being loaded.  If f returns true,
+// the signal is handled and the load-failed signal is not emitted.
+//
+// A certificate may be allowed for the host with
+// WebContext.AllowTLSCertificateForHost before reloading the WebView.
+func (w *WebView) OnLoadFailedWithTLSErrors(f func(info *CertificateInfo, host string) bool) (glib.SignalHandle, error) {
+	return w.Connect("load-failed-with-tls-errors", func(_ *WebView,
+		info *CertificateInfo, host string) bool {
+
+		return f(info, host)
+	})
+}
+
+// OnInsecureContentDetected connects f to the WebView's
+// insecure-content-detected signal.  f is called when a page loaded over
+// HTTPS runs or displays content loaded insecurely.
+func (w *WebView) OnInsecureContentDetected(f func(InsecureContentEvent)) (glib.SignalHandle, error) {
+	return w.Connect("insecure-content-detected", func(_ *WebView,
+		e InsecureContentEvent) {
+
+		f(e)
+	})
+}
+
+// securityTLSErrorsHookOnce adds the emission hook of
+// addSecurityTLSErrorsHook only once.
+var securityTLSErrorsHookOnce sync.Once
+
+// addSecurityTLSErrorsHook adds an emission hook to the
+// load-failed-with-tls-errors signal of every WebView, marking a WebView
+// whose security state is followed as broken.  Unlike a signal handler,
+// the hook runs for every emission, even one stopped by a handler
+// returning true.
+func addSecurityTLSErrorsHook() {
+	securityTLSErrorsHookOnce.Do(func() {
+		C.addSecurityTLSErrorsHook()
+	})
+}
+
+//export goSecurityTLSErrorsHook
+func goSecurityTLSErrorsHook(v *C.WebKitWebView) {
+	securityTLSErrors(uintptr(unsafe.Pointer(v)))
+}
+
+// OnDecidePolicy connects p to the WebView's decide-policy signal.  The
+// decision passed to p holds a reference to the underlying
+// WebKitPolicyDecision, so it may be kept and decided after p returns.
+func (w *WebView) OnDecidePolicy(p PolicyDecider) (glib.SignalHandle, error) {
+	return w.Connect("decide-policy", func(_ *WebView, d *PolicyDecision,
+		t PolicyDecisionType) bool {
+
+		d.RefSink()
+		runtime.SetFinalizer(d.Object, (*glib.Object).Unref)
+		switch t {
+		case PolicyDecisionTypeNavigationAction,
+			PolicyDecisionTypeNewWindowAction:
+			return p.DecideNavigationPolicy(wrapNavigationPolicyDecision(d.Object), t)
+		case PolicyDecisionTypeResponse:
+			return p.DecideResponsePolicy(wrapResponsePolicyDecision(d.Object))
+		}
+		return false
+	})
+}
+
+// OnCreate connects f to the WebView's create signal, which is emitted
+// when the page requests a new window, such as with window.open.  f
+// should return a new WebView, usually created with
+// NewWebViewWithRelatedView, or nil to block the new window.  The
+// returned WebView should not be shown until its ready-to-show signal is
+// emitted.
+//
+// The request for the new window is not passed to the create signal by
+// WebKit2GTK+ 2.4, but is available to a PolicyDecider as a
+// NavigationPolicyDecision of type PolicyDecisionTypeNewWindowAction,
+// which is decided before create is emitted.
+func (w *WebView) OnCreate(f func() *WebView) (glib.SignalHandle, error) {
+	id := registerSignalHandler(f)
+	c := C.connectWebViewCreate(w.native(), C.guint(id))
+	return glib.SignalHandle(c), nil
+}
+
+//export goWebViewCreate
+func goWebViewCreate(v *C.WebKitWebView, data C.gpointer) *C.GtkWidget {
+	f, ok := signalHandler(data).(func() *WebView)
+	if !ok {
+		return nil
+	}
+	view := f()
+	if view == nil {
+		return nil
+	}
+	// The returned view is owned by WebKit (transfer full), and must
+	// outlive the Go wrapper, which the caller may drop before the view
+	// is shown.
+	C.g_object_ref(C.gpointer(unsafe.Pointer(view.native())))
+	return (*C.GtkWidget)(unsafe.Pointer(view.native()))
+}
+
+// OnContextMenu connects f to the WebView's context-menu signal.  f is
+// called with the context menu about to be shown, which it may modify,
+// and the result of a hit test at the position the menu was requested.
+// If f returns true, the signal is handled and the menu is not shown.
+func (w *WebView) OnContextMenu(f func(menu *ContextMenu, hit *HitTestResult) bool) (glib.SignalHandle, error) {
+	// The GdkEvent passed to the context-menu signal may not have a
+	// GValue marshaler registered, so the signal is connected with a C
+	// callback rather than glib.Object.Connect.
+	id := registerSignalHandler(f)
+	c := C.connectWebViewContextMenu(w.native(), C.guint(id))
+	return glib.SignalHandle(c), nil
+}
+
+//export goWebViewContextMenu
+func goWebViewContextMenu(v *C.WebKitWebView, menu *C.WebKitContextMenu,
+	event *C.GdkEvent, hit *C.WebKitHitTestResult, data C.gpointer) C.gboolean {
+
+	f, ok := signalHandler(data).(func(*ContextMenu, *HitTestResult) bool)
+	if !ok {
+		return gbool(false)
+	}
+	menuObj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(menu))}
+	menuObj.RefSink()
+	runtime.SetFinalizer(menuObj, (*glib.Object).Unref)
+	hitObj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(hit))}
+	hitObj.RefSink()
+	runtime.SetFinalizer(hitObj, (*glib.Object).Unref)
+	return gbool(f(wrapContextMenu(menuObj), wrapHitTestResult(hitObj)))
+}
+
+// OnScriptDialog connects f to the WebView's script-dialog signal.  f is
+// called when a script calls alert, confirm or prompt, but not for
+// beforeunload confirmations, which WebKit2GTK+ 2.4 does not report.  If f returns
+// true, the signal is handled and no dialog is shown; the script
+// receives the responses set with ScriptDialog.ConfirmSetConfirmed or
+// ScriptDialog.PromptSetText, if any.
+func (w *WebView) OnScriptDialog(f func(*ScriptDialog) bool) (glib.SignalHandle, error) {
+	return w.Connect("script-dialog", func(_ *WebView, d *ScriptDialog) bool {
+		return f(d)
+	})
+}
+
+// OnPermissionRequest connects f to the WebView's permission-request
+// signal.  The request passed to f holds a reference to the underlying
+// WebKitPermissionRequest, so it may be kept and decided after f
+// returns.  If f returns true, the signal is handled.
+func (w *WebView) OnPermissionRequest(f func(PermissionRequest) bool) (glib.SignalHandle, error) {
+	return w.Connect("permission-request", func(_ *WebView,
+		r PermissionRequest) bool {
+
+		if obj := permissionRequestObject(r); obj != nil {
+			obj.RefSink()
+			runtime.SetFinalizer(obj, (*glib.Object).Unref)
+		}
+		return f(r)
+	})
+}
+
+// SetPermissionPolicy connects p to the WebView's permission-request
+// signal.  As WebKit2GTK+ 2.4 does not report the origin making a
+// permission request, the origin passed to p is that of the WebView's
+// current URI.
+func (w *WebView) SetPermissionPolicy(p PermissionPolicy) (glib.SignalHandle, error) {
+	return w.OnPermissionRequest(func(r PermissionRequest) bool {
+		return p.DecidePermission(uriOrigin(w.URI()), r)
+	})
+}
+
+// OnAuthenticate connects f to the WebView's authenticate signal.  The
+// request passed to f holds a reference to the underlying
+// WebKitAuthenticationRequest, so it may be kept and answered after f
+// returns.  If f returns true, the signal is handled and no
+// authentication dialog is shown.  A CredentialProvider of the WebView's
+// WebContext is consulted before f; see NewWebView.
+func (w *WebView) OnAuthenticate(f func(*AuthenticationRequest) bool) (glib.SignalHandle, error) {
+	return w.Connect("authenticate", func(_ *WebView,
+		r *AuthenticationRequest) bool {
+
+		r.RefSink()
+		runtime.SetFinalizer(r.Object, (*glib.Object).Unref)
+		return f(r)
+	})
+}
+
+// OnRunFileChooser connects f to the WebView's run-file-chooser signal.
+// f is called when the page requests files, such as for an input
+// element of type file.  The request passed to f holds a reference to the
+// underlying WebKitFileChooserRequest, so it may be kept and answered
+// after f returns.  If f returns true, the signal is handled and no file
+// chooser dialog is shown.
+func (w *WebView) OnRunFileChooser(f func(*FileChooserRequest) bool) (glib.SignalHandle, error) {
+	return w.Connect("run-file-chooser", func(_ *WebView,
+		r *FileChooserRequest) bool {
+
+		r.RefSink()
+		runtime.SetFinalizer(r.Object, (*glib.Object).Unref)
+		return f(r)
+	})
+}
+
+// OnMouseTargetChanged connects f to the WebView's mouse-target-changed
+// signal.  f is called with the result of a hit test at the new position
+// of the mouse pointer, and the keyboard modifier mask at the time.
+func (w *WebView) OnMouseTargetChanged(f func(hit *HitTestResult, modifiers uint)) (glib.SignalHandle, error) {
+	return w.Connect("mouse-target-changed", func(_ *WebView,
+		hit *HitTestResult, modifiers uint) {
+
+		hit.RefSink()
+		runtime.SetFinalizer(hit.Object, (*glib.Object).Unref)
+		f(hit, modifiers)
+	})
+}
+
+// OnResourceLoadStarted connects f to the WebView's
+// resource-load-started signal.  f is called with each resource the
+// WebView starts loading and the request made for it.  Signal handlers
+// may be connected to the resource to follow the rest of its load.
+func (w *WebView) OnResourceLoadStarted(f func(resource *WebResource, request *URIRequest)) (glib.SignalHandle, error) {
+	return w.Connect("resource-load-started", func(_ *WebView,
+		resource *WebResource, request *URIRequest) {
+
+		resource.RefSink()
+		runtime.SetFinalizer(resource.Object, (*glib.Object).Unref)
+		request.RefSink()
+		runtime.SetFinalizer(request.Object, (*glib.Object).Unref)
+		f(resource, request)
+	})
+}
+
+// OnReadyToShow connects f to the WebView's ready-to-show signal.  f is
+// called when a WebView returned by a create handler has its window
+// properties set and may be shown.
+func (w *WebView) OnReadyToShow(f func()) (glib.SignalHandle, error) {
+	return w.Connect("ready-to-show", func(_ *WebView) {
+		f()
+	})
+}
+
+// OnClose connects f to the WebView's close signal.  f is called when
+// the page requests the WebView be closed, such as with window.close,
+// and should destroy the WebView.
+func (w *WebView) OnClose(f func()) (glib.SignalHandle, error) {
+	return w.Connect("close", func(_ *WebView) {
+		f()
+	})
+}
+
+// OnEnterFullscreen connects f to the WebView's enter-fullscreen signal.
+// f is called when a script requests fullscreen, such as with
+// element.webkitRequestFullScreen.  If f returns true, the signal is
+// handled and the request is denied.
+func (w *WebView) OnEnterFullscreen(f func() bool) (glib.SignalHandle, error) {
+	return w.Connect("enter-fullscreen", func(_ *WebView) bool {
+		return f()
+	})
+}
+
+// OnLeaveFullscreen connects f to the WebView's leave-fullscreen signal.
+// f is called when the WebView is about to leave fullscreen.  If f
+// returns true, the signal is handled and the WebView remains
+// fullscreen.
+func (w *WebView) OnLeaveFullscreen(f func() bool) (glib.SignalHandle, error) {
+	return w.Connect("leave-fullscreen", func(_ *WebView) bool {
+		return f()
+	})
+}
+
+// FullscreenWindow connects handlers to the WebView's enter-fullscreen
+// and leave-fullscreen signals to fullscreen the gtk.Window containing
+// the WebView.  While fullscreen, every other visible widget in the
+// window, such as toolbars and status bars, is hidden, and these widgets
+// are shown again when fullscreen is left.  Handlers connected with
+// OnEnterFullscreen before FullscreenWindow may still deny requests.
+func (w *WebView) FullscreenWindow() error {
+	// hidden holds a reference on each hidden widget, so none is freed
+	// while fullscreen, even if its window is destroyed.
+	var hidden []*C.GtkWidget
+	release := func(show bool) {
+		for _, c := range hidden {
+			if show && !gobool(C.gtk_widget_in_destruction(c)) {
+				C.gtk_widget_show(c)
+			}
+			C.g_object_unref(C.gpointer(unsafe.Pointer(c)))
+		}
+		hidden = nil
+	}
+	_, err := w.OnEnterFullscreen(func() bool {
+		widget := (*C.GtkWidget)(unsafe.Pointer(w.native()))
+		top := C.gtk_widget_get_toplevel(widget)
+		if !gobool(C.isGtkWindow(unsafe.Pointer(top))) {
+			return false
+		}
+		// The chrome is already hidden if fullscreen was entered
+		// again without being left.
+		if hidden == nil {
+			hidden = hideChrome(widget)
+		}
+		C.gtk_window_fullscreen(C.toGtkWindow(unsafe.Pointer(top)))
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.OnLeaveFullscreen(func() bool {
+		release(true)
+		widget := (*C.GtkWidget)(unsafe.Pointer(w.native()))
+		top := C.gtk_widget_get_toplevel(widget)
+		if gobool(C.isGtkWindow(unsafe.Pointer(top))) {
+			C.gtk_window_unfullscreen(C.toGtkWindow(unsafe.Pointer(top)))
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Connect("destroy", func() {
+		release(false)
+	})
+	return err
+}
+
+// hideChrome hides the visible siblings of widget and of each of its
+// ancestors, returning the hidden widgets.  A reference is held on each
+// returned widget.
+func hideChrome(widget *C.GtkWidget) []*C.GtkWidget {
+	var hidden []*C.GtkWidget
+	for child := widget; ; {
+		parent := C.gtk_widget_get_parent(child)
+		if parent == nil {
+			return hidden
+		}
+		children := C.gtk_container_get_children(
+			C.toGtkContainer(unsafe.Pointer(parent)))
+		for l := children; l != nil; l = l.next {
+			c := (*C.GtkWidget)(unsafe.Pointer(l.data))
+			if c == child || !gobool(C.gtk_widget_get_visible(c)) {
+				continue
+			}
+			C.g_object_ref(C.gpointer(unsafe.Pointer(c)))
+			C.gtk_widget_hide(c)
+			hidden = append(hidden, c)
+		}
+		C.g_list_free(children)
+		child = parent
+	}
+}
+
+// OnWebProcessCrashed connects f to the WebView's web-process-crashed
+// signal.  f is called when the web process rendering the WebView's page
+// crashes.  If f returns true, the signal is handled.
+func (w *WebView) OnWebProcessCrashed(f func() bool) (glib.SignalHandle, error) {
+	return w.Connect("web-process-crashed", func(_ *WebView) bool {
+		return f()
+	})
+}
+
+// JavaScriptError describes an exception thrown by a script run with
+// RunJavaScript or RunJavaScriptInto.  Line and SourceURI are zero if the
+// exception does not report them, and for a script which can not be
+// parsed, whose Message is only WebKit's report of the failure.
+type JavaScriptError struct {
+	Message   string
+	Line      int
+	SourceURI string
+}
+
+// Error satisfies the error interface.
+func (e *JavaScriptError) Error() string {
+	if e.SourceURI == "" && e.Line == 0 {
+		return "javascript: " + e.Message
+	}
+	return fmt.Sprintf("javascript: %s:%d: %s", e.SourceURI, e.Line,
+		e.Message)
+}
+
+// jsWrapper is the script run in place of the script passed to
+// RunJavaScriptInto.  The script is run as the block of a try statement,
+// whose completion value is the result of the script, so no eval is
+// needed and pages whose Content-Security-Policy forbids eval are
+// supported.  An exception thrown by the script is caught, and its
+// message, line and source URL are returned in place of the result as
+// the property __wk2Exception of a sentinel object.
+const jsWrapper = `try {
+%s
+} catch (e) {
+	({__wk2Exception: {
+		message: (e instanceof Error) ? e.message : String(e),
+		line: (e && e.line) || 0,
+		sourceURL: (e && e.sourceURL) || ""
+	}})
+}`
+
+// jsException is the JSON encoding of the sentinel object returned by
+// jsWrapper for a thrown exception.
+type jsException struct {
+	Exception *struct {
+		Message   string `json:"message"`
+		Line      int    `json:"line"`
+		SourceURL string `json:"sourceURL"`
+	} `json:"__wk2Exception"`
+}
+
+// RunJavaScript is a wrapper around webkit_web_view_run_javascript() and
+// webkit_web_view_run_javascript_finish().  The result of the script is
+// converted to JSON by JavaScriptCore and returned as a string, float64,
+// bool, nil, []interface{}, or map[string]interface{}, following the
+// rules of encoding/json.  An undefined result is returned as nil.  An
+// exception thrown by the script, or a script which can not be parsed, is
+// returned as a *JavaScriptError; any other failure, such as cancellation
+// or a result which can not be converted to JSON, is returned as a
+// different error.
+func (w *WebView) RunJavaScript(ctx context.Context, script string) (interface{}, error) {
+	var v interface{}
+	if err := w.RunJavaScriptInto(ctx, script, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RunJavaScriptInto runs script in the same manner as RunJavaScript, but
+// decodes the result into the value pointed to by v using
+// encoding/json.
+func (w *WebView) RunJavaScriptInto(ctx context.Context, script string, v interface{}) error {
+	wrapped := fmt.Sprintf(jsWrapper, script)
+
+	var result string
+	err := runAsync(ctx, func(cancellable *C.GCancellable,
+		callback C.GAsyncReadyCallback, data C.gpointer) {
+
+		cstr := C.CString(wrapped)
+		defer C.free(unsafe.Pointer(cstr))
+		C.webkit_web_view_run_javascript(w.native(), (*C.gchar)(cstr),
+			cancellable, callback, data)
+	}, func(res *C.GAsyncResult) error {
+		var gerr *C.GError
+		c := C.webkit_web_view_run_javascript_finish(w.native(), res, &gerr)
+		if c == nil {
+			// Exceptions are caught by jsWrapper, so a script
+			// failure here is one the wrapper can not catch,
+			// such as a syntax error.
+			if gerr.domain == C.webkit_javascript_error_quark() &&
+				gerr.code == C.WEBKIT_JAVASCRIPT_ERROR_SCRIPT_FAILED {
+
+				return &JavaScriptError{Message: goError(gerr).Error()}
+			}
+			return goError(gerr)
+		}
+		defer C.webkit_javascript_result_unref(c)
+
+		cjson := C.javascriptResultJSON(c)
+		if cjson == nil {
+			return errors.New("wk2: javascript result can not be converted to JSON")
+		}
+		defer C.g_free(C.gpointer(unsafe.Pointer(cjson)))
+		result = C.GoString((*C.char)(cjson))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var e jsException
+	if json.Unmarshal([]byte(result), &e) == nil && e.Exception != nil {
+		return &JavaScriptError{
+			Message:   e.Exception.Message,
+			Line:      e.Exception.Line,
+			SourceURI: e.Exception.SourceURL,
+		}
+	}
+	return json.Unmarshal([]byte(result), v)
+}
+
 //
 // WebKitWebViewGroup
 //
@@ -916,3 +5042,104 @@ func (w *WebViewGroup) native() *C.WebKitWebViewGroup {
 	p := unsafe.Pointer(w.GObject)
 	return C.toWebKitWebViewGroup(p)
 }
+
+// Settings is a wrapper around webkit_web_view_group_get_settings().
+func (w *WebViewGroup) Settings() *Settings {
+	c := C.webkit_web_view_group_get_settings(w.native())
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapSettings(obj)
+}
+
+// SetSettings is a wrapper around webkit_web_view_group_set_settings().
+func (w *WebViewGroup) SetSettings(settings *Settings) {
+	C.webkit_web_view_group_set_settings(w.native(), settings.native())
+}
+
+//
+// WebKitWindowProperties
+//
+
+// WindowProperties is a representation of WebKit2GTK+'s
+// WebKitWindowProperties.
+type WindowProperties struct {
+	*glib.Object
+}
+
+func marshalWindowProperties(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{GObject: glib.ToGObject(unsafe.Pointer(c))}
+	return wrapWindowProperties(obj), nil
+}
+
+func wrapWindowProperties(obj *glib.Object) *WindowProperties {
+	return &WindowProperties{obj}
+}
+
+// native returns a pointer to the underlying WebKitWindowProperties.
+func (p *WindowProperties) native() *C.WebKitWindowProperties {
+	if p == nil || p.GObject == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(p.GObject)
+	return C.toWebKitWindowProperties(ptr)
+}
+
+// Geometry is a wrapper around webkit_window_properties_get_geometry().
+// The position and size of the window are returned.
+func (p *WindowProperties) Geometry() (x, y, width, height int) {
+	var r C.GdkRectangle
+	C.webkit_window_properties_get_geometry(p.native(), &r)
+	return int(r.x), int(r.y), int(r.width), int(r.height)
+}
+
+// ToolbarVisible is a wrapper around
+// webkit_window_properties_get_toolbar_visible().
+func (p *WindowProperties) ToolbarVisible() bool {
+	c := C.webkit_window_properties_get_toolbar_visible(p.native())
+	return gobool(c)
+}
+
+// StatusbarVisible is a wrapper around
+// webkit_window_properties_get_statusbar_visible().
+func (p *WindowProperties) StatusbarVisible() bool {
+	c := C.webkit_window_properties_get_statusbar_visible(p.native())
+	return gobool(c)
+}
+
+// ScrollbarsVisible is a wrapper around
+// webkit_window_properties_get_scrollbars_visible().
+func (p *WindowProperties) ScrollbarsVisible() bool {
+	c := C.webkit_window_properties_get_scrollbars_visible(p.native())
+	return gobool(c)
+}
+
+// MenubarVisible is a wrapper around
+// webkit_window_properties_get_menubar_visible().
+func (p *WindowProperties) MenubarVisible() bool {
+	c := C.webkit_window_properties_get_menubar_visible(p.native())
+	return gobool(c)
+}
+
+// LocationbarVisible is a wrapper around
+// webkit_window_properties_get_locationbar_visible().
+func (p *WindowProperties) LocationbarVisible() bool {
+	c := C.webkit_window_properties_get_locationbar_visible(p.native())
+	return gobool(c)
+}
+
+// Resizable is a wrapper around webkit_window_properties_get_resizable().
+func (p *WindowProperties) Resizable() bool {
+	c := C.webkit_window_properties_get_resizable(p.native())
+	return gobool(c)
+}
+
+// Fullscreen is a wrapper around webkit_window_properties_get_fullscreen().
+func (p *WindowProperties) Fullscreen() bool {
+	c := C.webkit_window_properties_get_fullscreen(p.native())
+	return gobool(c)
+}
@@ -4,6 +4,87 @@
  * license that can be found in the LICENSE file.
  */
 
+extern void goAsyncReadyCallback(GObject *, GAsyncResult *, gpointer);
+
+static GAsyncReadyCallback
+asyncReadyCallback(void)
+{
+	return ((GAsyncReadyCallback)goAsyncReadyCallback);
+}
+
+static gpointer
+uintToGpointer(guint i)
+{
+	return (GUINT_TO_POINTER(i));
+}
+
+static guint
+gpointerToUint(gpointer p)
+{
+	return (GPOINTER_TO_UINT(p));
+}
+
+extern GtkWidget *goWebViewCreate(WebKitWebView *, gpointer);
+extern void goSignalHandlerDestroy(gpointer, GClosure *);
+
+static gulong
+connectWebViewCreate(WebKitWebView *v, guint id)
+{
+	return (g_signal_connect_data(v, "create", G_CALLBACK(goWebViewCreate),
+	    GUINT_TO_POINTER(id), goSignalHandlerDestroy, 0));
+}
+
+extern gboolean goWebViewContextMenu(WebKitWebView *, WebKitContextMenu *,
+    GdkEvent *, WebKitHitTestResult *, gpointer);
+
+static gulong
+connectWebViewContextMenu(WebKitWebView *v, guint id)
+{
+	return (g_signal_connect_data(v, "context-menu",
+	    G_CALLBACK(goWebViewContextMenu), GUINT_TO_POINTER(id),
+	    goSignalHandlerDestroy, 0));
+}
+
+extern void goCredentialProviderDestroy(gpointer);
+
+static void
+setCredentialProvider(WebKitWebContext *c, guint id)
+{
+	if (id == 0) {
+		g_object_set_data(G_OBJECT(c), "wk2-credential-provider", NULL);
+		return;
+	}
+	g_object_set_data_full(G_OBJECT(c), "wk2-credential-provider",
+	    GUINT_TO_POINTER(id), goCredentialProviderDestroy);
+}
+
+static guint
+credentialProvider(WebKitWebContext *c)
+{
+	return (GPOINTER_TO_UINT(g_object_get_data(G_OBJECT(c),
+	    "wk2-credential-provider")));
+}
+
+extern void goSecurityTLSErrorsHook(WebKitWebView *);
+
+static gboolean
+securityTLSErrorsHook(GSignalInvocationHint *hint, guint n,
+    const GValue *params, gpointer data)
+{
+	goSecurityTLSErrorsHook(WEBKIT_WEB_VIEW(g_value_get_object(&params[0])));
+	return (TRUE);
+}
+
+static void
+addSecurityTLSErrorsHook(void)
+{
+	guint		id;
+
+	id = g_signal_lookup("load-failed-with-tls-errors",
+	    WEBKIT_TYPE_WEB_VIEW);
+	g_signal_add_emission_hook(id, 0, securityTLSErrorsHook, NULL, NULL);
+}
+
 static gchar **
 allocGCharArray(size_t n)
 {
@@ -40,6 +121,56 @@ pokeGCharArray(gchar **v, int n, gchar *s)
 	v[n] = s;
 }
 
+static gchar *
+javascriptResultJSON(WebKitJavascriptResult *r)
+{
+	JSGlobalContextRef	ctx;
+	JSValueRef		value;
+	JSStringRef		js;
+	size_t			n;
+	gchar *			s;
+
+	ctx = webkit_javascript_result_get_global_context(r);
+	value = webkit_javascript_result_get_value(r);
+	if (JSValueIsUndefined(ctx, value)) {
+		return (g_strdup("null"));
+	}
+
+	js = JSValueCreateJSONString(ctx, value, 0, NULL);
+	if (js == NULL) {
+		return (NULL);
+	}
+	n = JSStringGetMaximumUTF8CStringSize(js);
+	s = g_malloc(n);
+	JSStringGetUTF8CString(js, s, n);
+	JSStringRelease(js);
+	return (s);
+}
+
+static GtkContainer *
+toGtkContainer(void *p)
+{
+	return (GTK_CONTAINER(p));
+}
+
+static GtkWindow *
+toGtkWindow(void *p)
+{
+	return (GTK_WINDOW(p));
+}
+
+static gboolean
+isGtkWindow(void *p)
+{
+	return (GTK_IS_WINDOW(p));
+}
+
+static WebKitAuthenticationRequest *
+toWebKitAuthenticationRequest(void *p)
+{
+	return (WEBKIT_AUTHENTICATION_REQUEST(p));
+}
+
 static WebKitBackForwardList *
 toWebKitBackForwardList(void *p)
 {
@@ -52,6 +183,18 @@ toWebKitBackForwardListItem(void *p)
 	return (WEBKIT_BACK_FORWARD_LIST_ITEM(p));
 }
 
+static WebKitContextMenu *
+toWebKitContextMenu(void *p)
+{
+	return (WEBKIT_CONTEXT_MENU(p));
+}
+
+static WebKitContextMenuItem *
+toWebKitContextMenuItem(void *p)
+{
+	return (WEBKIT_CONTEXT_MENU_ITEM(p));
+}
+
 static WebKitCookieManager *
 toWebKitCookieManager(void *p)
 {
@@ -70,24 +213,118 @@ toWebKitFaviconDatabase(void *p)
 	return (WEBKIT_FAVICON_DATABASE(p));
 }
 
+static WebKitFileChooserRequest *
+toWebKitFileChooserRequest(void *p)
+{
+	return (WEBKIT_FILE_CHOOSER_REQUEST(p));
+}
+
+static WebKitFindController *
+toWebKitFindController(void *p)
+{
+	return (WEBKIT_FIND_CONTROLLER(p));
+}
+
+static WebKitGeolocationPermissionRequest *
+toWebKitGeolocationPermissionRequest(void *p)
+{
+	return (WEBKIT_GEOLOCATION_PERMISSION_REQUEST(p));
+}
+
+static gboolean
+isWebKitGeolocationPermissionRequest(void *p)
+{
+	return (WEBKIT_IS_GEOLOCATION_PERMISSION_REQUEST(p));
+}
+
+static WebKitHitTestResult *
+toWebKitHitTestResult(void *p)
+{
+	return (WEBKIT_HIT_TEST_RESULT(p));
+}
+
+static WebKitNavigationPolicyDecision *
+toWebKitNavigationPolicyDecision(void *p)
+{
+	return (WEBKIT_NAVIGATION_POLICY_DECISION(p));
+}
+
+static WebKitPermissionRequest *
+toWebKitPermissionRequest(void *p)
+{
+	return (WEBKIT_PERMISSION_REQUEST(p));
+}
+
+static WebKitPolicyDecision *
+toWebKitPolicyDecision(void *p)
+{
+	return (WEBKIT_POLICY_DECISION(p));
+}
+
+static WebKitResponsePolicyDecision *
+toWebKitResponsePolicyDecision(void *p)
+{
+	return (WEBKIT_RESPONSE_POLICY_DECISION(p));
+}
+
 static WebKitSecurityManager *
 toWebKitSecurityManager(void *p)
 {
 	return (WEBKIT_SECURITY_MANAGER(p));
 }
 
+static WebKitSettings *
+toWebKitSettings(void *p)
+{
+	return (WEBKIT_SETTINGS(p));
+}
+
+static GByteArray *
+tlsCertificateDER(GTlsCertificate *cert)
+{
+	GByteArray *	der;
+
+	der = NULL;
+	g_object_get(cert, "certificate", &der, NULL);
+	return (der);
+}
+
+static GTlsCertificate *
+toGTlsCertificate(void *p)
+{
+	return (G_TLS_CERTIFICATE(p));
+}
+
 static WebKitURIRequest *
 toWebKitURIRequest(void *p)
 {
 	return (WEBKIT_URI_REQUEST(p));
 }
 
+static WebKitURIResponse *
+toWebKitURIResponse(void *p)
+{
+	return (WEBKIT_URI_RESPONSE(p));
+}
+
 static WebKitWebContext *
 toWebKitWebContext(void *p)
 {
 	return (WEBKIT_WEB_CONTEXT(p));
 }
 
+static WebKitWebInspector *
+toWebKitWebInspector(void *p)
+{
+	return (WEBKIT_WEB_INSPECTOR(p));
+}
+
+static WebKitWebResource *
+toWebKitWebResource(void *p)
+{
+	return (WEBKIT_WEB_RESOURCE(p));
+}
+
 static WebKitWebView *
 toWebKitWebView(void *p)
 {
@@ -99,3 +336,9 @@ toWebKitWebViewGroup(void *p)
 {
 	return (WEBKIT_WEB_VIEW_GROUP(p));
 }
+
+static WebKitWindowProperties *
+toWebKitWindowProperties(void *p)
+{
+	return (WEBKIT_WINDOW_PROPERTIES(p));
+}
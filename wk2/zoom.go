@@ -0,0 +1,110 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import (
+	"net/url"
+	"sync"
+)
+
+// ZoomStore remembers the zoom level of WebViews for each host, and
+// persists the levels to a JSON file.  A WebView attached to a ZoomStore
+// has the level for a host reapplied each time a page of that host is
+// committed, and any change to its zoom level is remembered for the host
+// of its current page.
+type ZoomStore struct {
+	path string
+
+	mu     sync.Mutex
+	levels map[string]float64
+}
+
+// OpenZoomStore returns a ZoomStore persisting zoom levels to the JSON
+// file at path.  Levels are loaded from the file if it exists.
+func OpenZoomStore(path string) (*ZoomStore, error) {
+	s := &ZoomStore{
+		path:   path,
+		levels: make(map[string]float64),
+	}
+	if err := readJSONFile(path, &s.levels); err != nil {
+		return nil, err
+	}
+	if s.levels == nil {
+		s.levels = make(map[string]float64)
+	}
+	return s, nil
+}
+
+// Level returns the zoom level remembered for host.  If no level has
+// been remembered, the default level of 1.0 is returned.
+func (s *ZoomStore) Level(host string) float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if level, ok := s.levels[host]; ok {
+		return level
+	}
+	return 1.0
+}
+
+// SetLevel remembers the zoom level for host and saves the ZoomStore.
+// Setting the default level of 1.0 forgets the host.
+func (s *ZoomStore) SetLevel(host string, level float64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if old, ok := s.levels[host]; ok && old == level {
+		return nil
+	}
+	if level == 1.0 {
+		if _, ok := s.levels[host]; !ok {
+			return nil
+		}
+		delete(s.levels, host)
+	} else {
+		s.levels[host] = level
+	}
+	return s.save()
+}
+
+// save writes the remembered levels to the ZoomStore's file.  The mutex
+// must be held.
+func (s *ZoomStore) save() error {
+	return writeJSONFile(s.path, s.levels)
+}
+
+// Attach connects w to the ZoomStore.  Whenever w commits a load, the
+// zoom level remembered for the host of its URI is applied, and whenever
+// the zoom level of w changes, it is remembered for that host.  Errors
+// saving the ZoomStore from the signal handlers are ignored.
+func (s *ZoomStore) Attach(w *WebView) error {
+	_, err := w.OnLoadChanged(func(e LoadEvent) {
+		if e != LoadCommitted {
+			return
+		}
+		if host := uriHost(w.URI()); host != "" {
+			w.SetZoomLevel(s.Level(host))
+		}
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Connect("notify::zoom-level", func() {
+		if host := uriHost(w.URI()); host != "" {
+			s.SetLevel(host, w.ZoomLevel())
+		}
+	})
+	return err
+}
+
+// uriHost returns the host of uri, or the empty string if uri can not be
+// parsed or has no host.
+func uriHost(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
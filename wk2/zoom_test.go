@@ -0,0 +1,98 @@
+// Copyright (c) 2014 Josh Rickmar.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wk2
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestZoomStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zoom.json")
+	s, err := OpenZoomStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if level := s.Level("example.com"); level != 1.0 {
+		t.Errorf("default level is %v, want 1.0", level)
+	}
+
+	if err := s.SetLevel("example.com", 1.5); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetLevel("example.org", 0.8); err != nil {
+		t.Fatal(err)
+	}
+	s, err = OpenZoomStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		host  string
+		level float64
+	}{
+		{"example.com", 1.5},
+		{"example.org", 0.8},
+		{"example.net", 1.0},
+	}
+	for _, test := range tests {
+		if level := s.Level(test.host); level != test.level {
+			t.Errorf("reopened level of %s is %v, want %v",
+				test.host, level, test.level)
+		}
+	}
+
+	// Setting the default level forgets the host.
+	if err := s.SetLevel("example.com", 1.0); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.levels["example.com"]; ok {
+		t.Error("host with the default level was not forgotten")
+	}
+	s, err = OpenZoomStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.levels["example.com"]; ok {
+		t.Error("forgotten host was saved")
+	}
+	if level := s.Level("example.org"); level != 0.8 {
+		t.Errorf("level of example.org is %v, want 0.8", level)
+	}
+}
+
+func TestOpenZoomStoreMissing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.json")
+	s, err := OpenZoomStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.levels) != 0 {
+		t.Errorf("new store has levels: %v", s.levels)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("opening a store created its file")
+	}
+}
+
+func TestURIHost(t *testing.T) {
+	tests := []struct {
+		uri  string
+		host string
+	}{
+		{"https://example.com/a?b", "example.com"},
+		{"http://example.com:8080/", "example.com:8080"},
+		{"about:blank", ""},
+		{"", ""},
+		{"%", ""},
+	}
+	for _, test := range tests {
+		if host := uriHost(test.uri); host != test.host {
+			t.Errorf("uriHost(%q) = %q, want %q", test.uri, host,
+				test.host)
+		}
+	}
+}